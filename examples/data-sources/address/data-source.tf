@@ -0,0 +1,22 @@
+data "gkegateway_address" "example" {
+  address   = "my-gateway-address"
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  region    = "us-central1"
+
+  lifecycle {
+    postcondition {
+      condition     = self.matches != false
+      error_message = "The gateway is not serving on its reserved address."
+    }
+  }
+}
+
+# Without a name, the reservation the gateway serves on is resolved.
+data "gkegateway_address" "resolved" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  region    = "us-central1"
+}
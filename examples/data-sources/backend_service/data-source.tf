@@ -4,3 +4,11 @@ data "gkegateway_backend_service" "example" {
   project   = "my-gcp-project"
   region    = "us-central1"
 }
+
+data "gkegateway_backend_service" "by_service" {
+  namespace = "my-cool-app"
+  port      = 8080
+  project   = "my-gcp-project"
+  region    = "us-central1"
+  service   = "my-service-name"
+}
@@ -0,0 +1,5 @@
+data "gkegateway_certificate_map" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+}
@@ -0,0 +1,10 @@
+data "gkegateway_gateway" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  region    = "us-central1"
+}
+
+output "topology" {
+  value = jsondecode(data.gkegateway_gateway.example.topology)
+}
@@ -0,0 +1,14 @@
+data "gkegateway_gateways" "example" {
+  project = "my-gcp-project"
+  region  = "us-central1"
+}
+
+# A gateway with several listeners has one entry per forwarding rule.
+data "gkegateway_security_policy" "example" {
+  for_each = toset([for g in data.gkegateway_gateways.example.gateways : "${g.namespace}/${g.gateway}"])
+
+  gateway   = split("/", each.value)[1]
+  namespace = split("/", each.value)[0]
+  project   = "my-gcp-project"
+  region    = "us-central1"
+}
@@ -0,0 +1,6 @@
+data "gkegateway_network_endpoint_groups" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  region    = "us-central1"
+}
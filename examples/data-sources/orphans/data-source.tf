@@ -0,0 +1,14 @@
+data "kubernetes_resources" "gateways" {
+  api_version = "gateway.networking.k8s.io/v1"
+  kind        = "Gateway"
+}
+
+data "gkegateway_orphans" "example" {
+  gateways = [for g in data.kubernetes_resources.gateways.objects : "${g.metadata.namespace}/${g.metadata.name}"]
+  project  = "my-gcp-project"
+  region   = "us-central1"
+}
+
+output "orphaned_forwarding_rules" {
+  value = data.gkegateway_orphans.example.forwarding_rules[*].self_link
+}
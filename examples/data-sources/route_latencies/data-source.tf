@@ -0,0 +1,10 @@
+data "gkegateway_route_latencies" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  lookback  = "24h"
+}
+
+output "p99_by_route" {
+  value = { for r in data.gkegateway_route_latencies.example.routes : r.path_rule => r.p99 }
+}
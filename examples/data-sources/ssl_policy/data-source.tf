@@ -0,0 +1,6 @@
+data "gkegateway_ssl_policy" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  region    = "us-central1"
+}
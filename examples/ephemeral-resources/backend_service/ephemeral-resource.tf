@@ -0,0 +1,6 @@
+ephemeral "gkegateway_backend_service" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  region    = "us-central1"
+}
@@ -0,0 +1,10 @@
+data "gkegateway_gateway" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+}
+
+# https://www.googleapis.com/compute/beta/projects/my-gcp-project/global/backendServices/gkegw1-...
+output "backend_service_beta_self_link" {
+  value = provider::gkegateway::beta_self_link(data.gkegateway_gateway.example.backend_services[0].self_link)
+}
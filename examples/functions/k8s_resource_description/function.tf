@@ -0,0 +1,6 @@
+resource "google_compute_backend_service" "companion" {
+  name = "my-companion-backend-service"
+
+  # {"k8sResource":"/namespaces/my-cool-app/gateways/my-gateway-name"}
+  description = provider::gkegateway::k8s_resource_description("my-cool-app", "my-gateway-name")
+}
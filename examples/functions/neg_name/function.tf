@@ -0,0 +1,4 @@
+# k8s1-1a2b3c4d-my-cool-app-my-service-8080-35ff6e1d
+output "neg_name" {
+  value = provider::gkegateway::neg_name("1a2b3c4d5e6f7a8b", "my-cool-app", "my-service", 8080)
+}
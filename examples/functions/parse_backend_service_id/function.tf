@@ -0,0 +1,10 @@
+data "gkegateway_backend_service" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+}
+
+locals {
+  # { name = "gkegw1-...", project = "my-gcp-project", region = null }
+  backend_service = provider::gkegateway::parse_backend_service_id(data.gkegateway_backend_service.example.backend_service.id)
+}
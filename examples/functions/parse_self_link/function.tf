@@ -0,0 +1,10 @@
+data "gkegateway_gateway" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+}
+
+locals {
+  # { name = "gkegw1-...", project = "my-gcp-project", region = null, scope = "global", type = "backendServices", zone = null }
+  backend_service = provider::gkegateway::parse_self_link(data.gkegateway_gateway.example.backend_services[0].self_link)
+}
@@ -0,0 +1,8 @@
+list "gkegateway_backend_service_patch" "example" {
+  provider = gkegateway
+
+  config {
+    project = "my-gcp-project"
+    region  = "us-central1"
+  }
+}
@@ -0,0 +1,9 @@
+import {
+  to = gkegateway_backend_service_patch.example
+
+  identity = {
+    gateway   = "my-grpc-gateway"
+    namespace = "my-cool-app"
+    project   = "my-gcp-project"
+  }
+}
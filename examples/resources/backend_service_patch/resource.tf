@@ -0,0 +1,8 @@
+resource "gkegateway_backend_service_patch" "example" {
+  gateway   = "my-grpc-gateway"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+
+  max_stream_duration = "3600s"
+  protocol            = "H2C"
+}
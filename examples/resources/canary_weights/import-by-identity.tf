@@ -0,0 +1,9 @@
+import {
+  to = gkegateway_canary_weights.example
+
+  identity = {
+    gateway   = "my-gateway-name"
+    namespace = "my-cool-app"
+    project   = "my-gcp-project"
+  }
+}
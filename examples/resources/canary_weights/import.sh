@@ -0,0 +1,3 @@
+# Global load balancers are imported as {{project}}/{{namespace}}/{{gateway}}, regional ones as
+# {{project}}/{{region}}/{{namespace}}/{{gateway}}.
+terraform import gkegateway_canary_weights.example my-gcp-project/my-cool-app/my-gateway-name
@@ -0,0 +1,10 @@
+resource "gkegateway_canary_weights" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+
+  weights = {
+    "gkegw1-abcd-my-cool-app-stable-8080-wxyz" = 90
+    "gkegw1-abcd-my-cool-app-canary-8080-wxyz" = 10
+  }
+}
@@ -0,0 +1,14 @@
+resource "gkegateway_neg_endpoint" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+  zone      = "us-central1-a"
+
+  endpoints = [
+    {
+      instance   = google_compute_instance.legacy.name
+      ip_address = google_compute_instance.legacy.network_interface[0].network_ip
+      port       = 8080
+    },
+  ]
+}
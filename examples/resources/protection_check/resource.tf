@@ -0,0 +1,14 @@
+resource "gkegateway_protection_check" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+
+  lookback                = "30m"
+  max_requests_per_second = 0.5
+
+  # Destroyed, and therefore checked, before the attachments it depends on.
+  depends_on = [
+    google_compute_security_policy.example,
+    google_dns_record_set.example,
+  ]
+}
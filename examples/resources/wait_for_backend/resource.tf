@@ -0,0 +1,9 @@
+resource "gkegateway_wait_for_backend" "example" {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+  project   = "my-gcp-project"
+
+  healthy_endpoints = 2
+  security_policy   = "my-security-policy"
+  timeout           = "15m"
+}
@@ -0,0 +1,30 @@
+mock_provider "gkegateway" {
+  source = "./mocks"
+}
+
+variables {
+  gateway   = "my-gateway-name"
+  namespace = "my-cool-app"
+}
+
+run "resolves_backend_service" {
+  command = plan
+
+  assert {
+    condition     = output.backend_service == "https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/backendServices/gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b"
+    error_message = "The backend service of the gateway was not resolved."
+  }
+}
+
+run "missing_backend_service" {
+  command = plan
+
+  override_data {
+    target = data.gkegateway_backend_service.this
+    values = {
+      backend_service = null
+    }
+  }
+
+  expect_failures = [output.backend_service]
+}
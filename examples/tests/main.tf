@@ -0,0 +1,24 @@
+# A module resolving the backend service of a gateway, tested by backend_service.tftest.hcl.
+
+variable "gateway" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
+
+data "gkegateway_backend_service" "this" {
+  gateway   = var.gateway
+  namespace = var.namespace
+  project   = "my-gcp-project"
+}
+
+output "backend_service" {
+  value = try(data.gkegateway_backend_service.this.backend_service.self_link, null)
+
+  precondition {
+    condition     = data.gkegateway_backend_service.this.backend_service != null
+    error_message = "GKE has not programmed the load balancer of the gateway yet."
+  }
+}
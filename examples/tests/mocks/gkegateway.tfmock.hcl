@@ -0,0 +1,65 @@
+# Realistic values for the data sources of the provider, for use with a mock_provider block in .tftest.hcl files:
+#
+#   mock_provider "gkegateway" {
+#     source = "./mocks"
+#   }
+#
+# Override any of them per run with override_data blocks.
+
+mock_data "gkegateway_address" {
+  defaults = {
+    address      = "my-gateway-address"
+    ip_address   = "203.0.113.10"
+    matches      = true
+    network_tier = "PREMIUM"
+    purpose      = ""
+    self_link    = "https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/addresses/my-gateway-address"
+  }
+}
+
+mock_data "gkegateway_backend_service" {
+  defaults = {
+    backend_service = {
+      backends = [
+        {
+          balancing_mode  = "RATE"
+          capacity_scaler = 1
+          group           = "https://www.googleapis.com/compute/v1/projects/my-gcp-project/zones/us-central1-a/networkEndpointGroups/k8s1-0a1b2c3d-my-cool-app-my-service-8080-4e5f6a7b"
+        },
+      ]
+      description           = "{\"kubernetes.io/service-name\":\"my-cool-app/my-service\",\"kubernetes.io/service-port\":\"8080\"}"
+      enable_cdn            = false
+      fingerprint           = "qV7x1YwnS0E="
+      id                    = "1234567890123456789"
+      load_balancing_scheme = "EXTERNAL_MANAGED"
+      max_stream_duration   = null
+      name                  = "gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b"
+      protocol              = "HTTP"
+      self_link             = "https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/backendServices/gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b"
+      timeout_sec           = 30
+    }
+  }
+}
+
+mock_data "gkegateway_gateways" {
+  defaults = {
+    gateways = [
+      {
+        forwarding_rule = "gkegw1-0a1b-my-cool-app-my-gateway-name-c8d9e0f1"
+        gateway         = "my-gateway-name"
+        ip_address      = "203.0.113.10"
+        namespace       = "my-cool-app"
+      },
+    ]
+  }
+}
+
+mock_data "gkegateway_security_policy" {
+  defaults = {
+    security_policy = {
+      name      = "my-security-policy"
+      self_link = "https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/securityPolicies/my-security-policy"
+      type      = "CLOUD_ARMOR"
+    }
+  }
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/iterator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AddressDataSource{}
+
+func NewAddressDataSource() datasource.DataSource {
+	return &AddressDataSource{}
+}
+
+// AddressDataSource defines the data source implementation.
+type AddressDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// AddressDataSourceModel describes the data source data model.
+type AddressDataSourceModel struct {
+	Address         types.String                           `tfsdk:"address"`
+	ForwardingRules []AddressDataSourceModelForwardingRule `tfsdk:"forwarding_rules"`
+	Gateway         types.String                           `tfsdk:"gateway"`
+	IPAddress       types.String                           `tfsdk:"ip_address"`
+	Matches         types.Bool                             `tfsdk:"matches"`
+	Namespace       types.String                           `tfsdk:"namespace"`
+	NetworkTier     types.String                           `tfsdk:"network_tier"`
+	Project         types.String                           `tfsdk:"project"`
+	Purpose         types.String                           `tfsdk:"purpose"`
+	Region          types.String                           `tfsdk:"region"`
+	SelfLink        types.String                           `tfsdk:"self_link"`
+}
+
+type AddressDataSourceModelForwardingRule struct {
+	IPAddress types.String `tfsdk:"ip_address"`
+	Matches   types.Bool   `tfsdk:"matches"`
+	Name      types.String `tfsdk:"name"`
+}
+
+func (d *AddressDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *AddressDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address"
+}
+
+func (d *AddressDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data AddressDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRules, diags := d.providerData.findGatewayForwardingRules(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var address *computepb.Address
+
+	if !data.Address.IsNull() {
+		address, diags = d.providerData.getAddress(ctx, project, region, data.Address.ValueString())
+		resp.Diagnostics.Append(diags...)
+	} else if len(forwardingRules) > 0 {
+		// Without a name, the reservation is whichever one holds the IP the gateway serves on.
+		address, diags = d.providerData.findAddress(ctx, project, region, forwardingRules[0].GetIPAddress())
+		resp.Diagnostics.Append(diags...)
+
+		if !resp.Diagnostics.HasError() && address == nil {
+			data.Matches = types.BoolValue(false)
+
+			resp.Diagnostics.AddWarning(
+				"Gateway is not using a reserved address",
+				fmt.Sprintf("The %s forwarding rule for gateway %s/%s serves on %s, which isn't a reserved address.", forwardingRules[0].GetName(), data.Namespace.ValueString(), data.Gateway.ValueString(), forwardingRules[0].GetIPAddress()),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is nothing else to report without a reservation.
+	if address == nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data.Address = types.StringValue(address.GetName())
+	data.IPAddress = types.StringValue(address.GetAddress())
+	data.NetworkTier = types.StringValue(address.GetNetworkTier())
+	data.Purpose = types.StringValue(address.GetPurpose())
+	data.SelfLink = types.StringValue(address.GetSelfLink())
+
+	// Without a forwarding rule there is nothing to compare the address to yet.
+	if len(forwardingRules) > 0 {
+		data.ForwardingRules = []AddressDataSourceModelForwardingRule{}
+		data.Matches = types.BoolValue(true)
+
+		mismatches := []string{}
+
+		for _, forwardingRule := range forwardingRules {
+			matches := forwardingRule.GetIPAddress() == address.GetAddress()
+
+			data.ForwardingRules = append(data.ForwardingRules, AddressDataSourceModelForwardingRule{
+				IPAddress: types.StringValue(forwardingRule.GetIPAddress()),
+				Matches:   types.BoolValue(matches),
+				Name:      types.StringValue(forwardingRule.GetName()),
+			})
+
+			if !matches {
+				mismatches = append(mismatches, fmt.Sprintf("  - %s serves on %s\n", forwardingRule.GetName(), forwardingRule.GetIPAddress()))
+			}
+		}
+
+		// An address missing from the gateway's addresses, or misnamed, silently falls back to an ephemeral IP.
+		if len(mismatches) > 0 {
+			data.Matches = types.BoolValue(false)
+
+			resp.Diagnostics.AddWarning(
+				"Gateway is not using the reserved address",
+				fmt.Sprintf("The %s address is %s, but the following forwarding rules for gateway %s/%s don't use it:\n\n%s", address.GetName(), address.GetAddress(), data.Namespace.ValueString(), data.Gateway.ValueString(), strings.Join(mismatches, "")),
+			)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *AddressDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the reserved static IP address, as referenced by a `NamedAddress` in the `addresses` of the gateway. If it is not provided, the reservation holding the IP address the gateway serves on is looked up.",
+				Optional:            true,
+			},
+			"forwarding_rules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IP address the forwarding rule serves on.",
+						},
+						"matches": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the forwarding rule serves on the reserved address.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the forwarding rule.",
+						},
+					},
+				},
+				MarkdownDescription: "The forwarding rules created for the gateway - will be null if none are found.",
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"ip_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reserved IP address - will be null if no reservation is found.",
+			},
+			"matches": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether every forwarding rule of the gateway serves on the reserved address - will be null if no forwarding rule is found.",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"network_tier": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The network tier of the reserved address, `PREMIUM` or `STANDARD`.",
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer and address belong. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"purpose": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The purpose of the reserved address, such as `SHARED_LOADBALANCER_VIP` - will be empty for most external addresses.",
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer and address belong. If it is not provided, the provider region is used. When neither are provided, they are presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"self_link": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URI of the reserved address.",
+			},
+		},
+		MarkdownDescription: "Resolves the static IP address reserved for the load balancer created from a Kubernetes Gateway resource by GKE, either by name or from the IP address the gateway serves on, and checks that the load balancer serves on it. A gateway whose `addresses` don't reference the reservation correctly is given an ephemeral IP without any error, so a warning is raised when a forwarding rule serves on another address. Use `matches` in a precondition or check block to fail instead.",
+	}
+}
+
+// findAddress returns the address reserved for the given IP, or nil when the IP isn't reserved.
+func (p *GKEGatewayProviderData) findAddress(ctx context.Context, project string, region types.String, ip string) (*computepb.Address, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := fmt.Sprintf(`address = "%s"`, ip)
+
+	var addressesIterator *compute.AddressIterator
+	if region.IsNull() {
+		addressesIterator = p.globalAddressesClient.List(ctx, &computepb.ListGlobalAddressesRequest{
+			Filter:  &filter,
+			Project: project,
+		})
+	} else {
+		addressesIterator = p.addressesClient.List(ctx, &computepb.ListAddressesRequest{
+			Filter:  &filter,
+			Project: project,
+			Region:  region.ValueString(),
+		})
+	}
+
+	address, err := addressesIterator.Next()
+
+	if err == iterator.Done {
+		return nil, diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up the address reserved for %s", ip), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return address, diags
+}
+
+// getAddress fetches the reserved address with the given name.
+func (p *GKEGatewayProviderData) getAddress(ctx context.Context, project string, region types.String, name string) (*computepb.Address, diag.Diagnostics) {
+	var (
+		address *computepb.Address
+		diags   diag.Diagnostics
+		err     error
+	)
+
+	if region.IsNull() {
+		address, err = p.globalAddressesClient.Get(ctx, &computepb.GetGlobalAddressRequest{
+			Address: name,
+			Project: project,
+		})
+	} else {
+		address, err = p.addressesClient.Get(ctx, &computepb.GetAddressRequest{
+			Address: name,
+			Project: project,
+			Region:  region.ValueString(),
+		})
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up address %s", name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return address, diags
+}
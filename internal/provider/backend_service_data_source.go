@@ -5,22 +5,26 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
-	compute "cloud.google.com/go/compute/apiv1"
 	"cloud.google.com/go/compute/apiv1/computepb"
-	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"google.golang.org/api/iterator"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &BackendServiceDataSource{}
+var (
+	_ datasource.DataSource                   = &BackendServiceDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &BackendServiceDataSource{}
+)
 
 func NewBackendServiceDataSource() datasource.DataSource {
 	return &BackendServiceDataSource{}
@@ -33,20 +37,127 @@ type BackendServiceDataSource struct {
 
 // BackendServiceDataSourceModel describes the data source data model.
 type BackendServiceDataSourceModel struct {
-	BackendService *BackendServiceDataSourceModelBackendService `tfsdk:"backend_service"`
-	Gateway        types.String                                 `tfsdk:"gateway"`
-	Namespace      types.String                                 `tfsdk:"namespace"`
-	Project        types.String                                 `tfsdk:"project"`
-	Region         types.String                                 `tfsdk:"region"`
+	BackendService         *BackendServiceDataSourceModelBackendService  `tfsdk:"backend_service"`
+	BackendServices        []BackendServiceDataSourceModelBackendService `tfsdk:"backend_services"`
+	DescriptionFormat      types.String                                  `tfsdk:"description_format"`
+	Disambiguation         types.String                                  `tfsdk:"disambiguation"`
+	FailIfMissing          types.Bool                                    `tfsdk:"fail_if_missing"`
+	ForwardingRuleName     types.String                                  `tfsdk:"forwarding_rule_name"`
+	ForwardingRuleSelfLink types.String                                  `tfsdk:"forwarding_rule_self_link"`
+	Gateway                types.String                                  `tfsdk:"gateway"`
+	GatewayUID             types.String                                  `tfsdk:"gateway_uid"`
+	Hostname               types.String                                  `tfsdk:"hostname"`
+	Kind                   types.String                                  `tfsdk:"kind"`
+	MatchedRegion          types.String                                  `tfsdk:"matched_region"`
+	Namespace              types.String                                  `tfsdk:"namespace"`
+	Network                types.String                                  `tfsdk:"network"`
+	Path                   types.String                                  `tfsdk:"path"`
+	Port                   types.Int64                                   `tfsdk:"port"`
+	Project                types.String                                  `tfsdk:"project"`
+	RedirectForwardingRule *BackendServiceDataSourceModelForwardingRule  `tfsdk:"redirect_forwarding_rule"`
+	Region                 types.String                                  `tfsdk:"region"`
+	Regions                types.List                                    `tfsdk:"regions"`
+	RouteRulePriority      types.Int64                                   `tfsdk:"route_rule_priority"`
+	Service                types.String                                  `tfsdk:"service"`
+	Subnetwork             types.String                                  `tfsdk:"subnetwork"`
+	Timeouts               *DataSourceTimeoutsModel                      `tfsdk:"timeouts"`
+	Wait                   *BackendServiceDataSourceModelWait            `tfsdk:"wait"`
+}
+
+type BackendServiceDataSourceModelBackend struct {
+	BalancingMode  types.String  `tfsdk:"balancing_mode"`
+	CapacityScaler types.Float64 `tfsdk:"capacity_scaler"`
+	Group          types.String  `tfsdk:"group"`
 }
 
 type BackendServiceDataSourceModelBackendService struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	AffinityCookieTTLSec         types.Int64                                    `tfsdk:"affinity_cookie_ttl_sec"`
+	Backends                     []BackendServiceDataSourceModelBackend         `tfsdk:"backends"`
+	CDNPolicy                    *BackendServiceDataSourceModelCDNPolicy        `tfsdk:"cdn_policy"`
+	CircuitBreakers              *BackendServiceDataSourceModelCircuitBreakers  `tfsdk:"circuit_breakers"`
+	ConnectionDrainingTimeoutSec types.Int64                                    `tfsdk:"connection_draining_timeout_sec"`
+	Description                  types.String                                   `tfsdk:"description"`
+	EnableCDN                    types.Bool                                     `tfsdk:"enable_cdn"`
+	Fingerprint                  types.String                                   `tfsdk:"fingerprint"`
+	IAP                          *BackendServiceDataSourceModelIAP              `tfsdk:"iap"`
+	ID                           types.String                                   `tfsdk:"id"`
+	LoadBalancingScheme          types.String                                   `tfsdk:"load_balancing_scheme"`
+	LocalityLBPolicy             types.String                                   `tfsdk:"locality_lb_policy"`
+	LogConfig                    *BackendServiceDataSourceModelLogConfig        `tfsdk:"log_config"`
+	MaxStreamDuration            types.String                                   `tfsdk:"max_stream_duration"`
+	Name                         types.String                                   `tfsdk:"name"`
+	OutlierDetection             *BackendServiceDataSourceModelOutlierDetection `tfsdk:"outlier_detection"`
+	PortName                     types.String                                   `tfsdk:"port_name"`
+	Protocol                     types.String                                   `tfsdk:"protocol"`
+	SecurityPolicy               *BackendServiceDataSourceModelSecurityPolicy   `tfsdk:"security_policy"`
+	SelfLink                     types.String                                   `tfsdk:"self_link"`
+	SessionAffinity              types.String                                   `tfsdk:"session_affinity"`
+	TimeoutSec                   types.Int64                                    `tfsdk:"timeout_sec"`
+}
+
+type BackendServiceDataSourceModelCDNPolicy struct {
+	CacheMode             types.String                                      `tfsdk:"cache_mode"`
+	ClientTTL             types.Int64                                       `tfsdk:"client_ttl"`
+	DefaultTTL            types.Int64                                       `tfsdk:"default_ttl"`
+	MaxTTL                types.Int64                                       `tfsdk:"max_ttl"`
+	NegativeCaching       types.Bool                                        `tfsdk:"negative_caching"`
+	NegativeCachingPolicy []BackendServiceDataSourceModelNegativeCachingTTL `tfsdk:"negative_caching_policy"`
+}
+
+type BackendServiceDataSourceModelCircuitBreakers struct {
+	MaxConnections           types.Int64 `tfsdk:"max_connections"`
+	MaxPendingRequests       types.Int64 `tfsdk:"max_pending_requests"`
+	MaxRequests              types.Int64 `tfsdk:"max_requests"`
+	MaxRequestsPerConnection types.Int64 `tfsdk:"max_requests_per_connection"`
+	MaxRetries               types.Int64 `tfsdk:"max_retries"`
+}
+
+type BackendServiceDataSourceModelForwardingRule struct {
+	IPAddress types.String `tfsdk:"ip_address"`
+	Name      types.String `tfsdk:"name"`
+	PortRange types.String `tfsdk:"port_range"`
+	SelfLink  types.String `tfsdk:"self_link"`
+}
+
+type BackendServiceDataSourceModelIAP struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Oauth2ClientID types.String `tfsdk:"oauth2_client_id"`
+}
+
+type BackendServiceDataSourceModelLogConfig struct {
+	Enable         types.Bool     `tfsdk:"enable"`
+	OptionalFields []types.String `tfsdk:"optional_fields"`
+	OptionalMode   types.String   `tfsdk:"optional_mode"`
+	SampleRate     types.Float64  `tfsdk:"sample_rate"`
+}
+
+type BackendServiceDataSourceModelNegativeCachingTTL struct {
+	Code types.Int64 `tfsdk:"code"`
+	TTL  types.Int64 `tfsdk:"ttl"`
+}
+
+type BackendServiceDataSourceModelOutlierDetection struct {
+	BaseEjectionTime                   types.String `tfsdk:"base_ejection_time"`
+	ConsecutiveErrors                  types.Int64  `tfsdk:"consecutive_errors"`
+	ConsecutiveGatewayFailure          types.Int64  `tfsdk:"consecutive_gateway_failure"`
+	EnforcingConsecutiveErrors         types.Int64  `tfsdk:"enforcing_consecutive_errors"`
+	EnforcingConsecutiveGatewayFailure types.Int64  `tfsdk:"enforcing_consecutive_gateway_failure"`
+	EnforcingSuccessRate               types.Int64  `tfsdk:"enforcing_success_rate"`
+	Interval                           types.String `tfsdk:"interval"`
+	MaxEjectionPercent                 types.Int64  `tfsdk:"max_ejection_percent"`
+	SuccessRateMinimumHosts            types.Int64  `tfsdk:"success_rate_minimum_hosts"`
+	SuccessRateRequestVolume           types.Int64  `tfsdk:"success_rate_request_volume"`
+	SuccessRateStdevFactor             types.Int64  `tfsdk:"success_rate_stdev_factor"`
+}
+
+type BackendServiceDataSourceModelSecurityPolicy struct {
+	Name     types.String `tfsdk:"name"`
+	SelfLink types.String `tfsdk:"self_link"`
 }
 
-type forwardingRuleDescription struct {
-	K8sResource *string `json:"k8sResource"`
+type BackendServiceDataSourceModelWait struct {
+	PollInterval types.String `tfsdk:"poll_interval"`
+	Timeout      types.String `tfsdk:"timeout"`
 }
 
 func (d *BackendServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -74,10 +185,9 @@ func (d *BackendServiceDataSource) Metadata(ctx context.Context, req datasource.
 }
 
 func (d *BackendServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var (
-		data BackendServiceDataSourceModel
-		err  error
-	)
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data BackendServiceDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -86,252 +196,1037 @@ func (d *BackendServiceDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	// project can be set at the provider or data source level, the latter taking precedence, but ultimately required.
-	if d.providerData.project.IsNull() && data.Project.IsNull() {
-		resp.Diagnostics.AddError("Missing project", "The project field must be set on either the provider or data source.")
-		return
-	}
+	resp.Diagnostics.Append(d.read(ctx, &data)...)
 
-	if data.Project.IsUnknown() {
-		resp.Diagnostics.AddError("Unknown project", "The project field on the data source cannot be set to an unknown value")
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	project := d.providerData.project.ValueString()
-	if !data.Project.IsNull() {
-		project = data.Project.ValueString()
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *BackendServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backend_service": schema.SingleNestedAttribute{
+				Attributes:          backendServiceAttributes(),
+				Computed:            true,
+				MarkdownDescription: "Details about the backend service - will be null if none is found, or if several are found with a `disambiguation` of `all`.",
+			},
+			"backend_services": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: backendServiceAttributes(),
+				},
+				MarkdownDescription: "Details about every matching backend service, sorted by name, when `disambiguation` is `all` - always null otherwise.",
+			},
+			"description_format": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "How the forwarding rule was matched to the gateway: `k8s-resource-path` for the `/namespaces/{{namespace}}/gateways/{{name}}` path GKE writes under the `description_key`, `kubernetes-io-gateway-name` for a `{{namespace}}/{{name}}` under `kubernetes.io/gateway-name`, or `labels` when matched by the `label_matching` of the provider - will be null when looking up a service.",
+			},
+			"disambiguation": schema.StringAttribute{
+				MarkdownDescription: "How several matching backend services are handled: `error` fails listing them, `newest` picks the most recently created one, `alphabetical` picks the first by name and `all` reports them all in `backend_services`. Defaults to `error`.",
+				Optional:            true,
+			},
+			"fail_if_missing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fail when no forwarding rule references the gateway, or no backend service was created for the service, rather than leaving `backend_service` null, so typos don't go unnoticed. Defaults to `false`.",
+				Optional:            true,
+			},
+			"forwarding_rule_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the forwarding rule of the gateway - will be null when looking up a service.",
+			},
+			"forwarding_rule_self_link": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URI of the forwarding rule of the gateway - will be null when looking up a service.",
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource, or Ingress resource when `kind` is `ingress`. At least one of `gateway` or `service` must be set.",
+				Optional:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"gateway_uid": schema.StringAttribute{
+				MarkdownDescription: "Kubernetes UID of the gateway resource, matched against the UID the controller embeds in the forwarding rule descriptions, so the load balancer of a gateway recreated under the same name is told apart from lingering ones of the deleted gateway. Rules matched by `label_matching`, or written by controllers that don't embed the UID, never match. Can only be set along with `gateway`.",
+				Optional:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the requests to follow through the URL map of the gateway, such as `www.example.com`, to select the backend service of that domain when the gateway serves several. Only the host rule matching the hostname is traversed, like the load balancer does, or the defaults of the URL map when none does. Can only be set along with `gateway`.",
+				Optional:            true,
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "The kind of Kubernetes resource `gateway` names, either `gateway` or `ingress` for the load balancers of the legacy GKE Ingress controller, recognized by the `kubernetes.io/ingress-name` it writes in the description of their `k8s2-` forwarding rules, so teams migrating from Ingress to Gateway can look up both. Defaults to `gateway`.",
+				Optional:            true,
+			},
+			"matched_region": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The region of `regions` the backend service was found in, `global` for a global load balancer - will be null when `regions` isn't set.",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway or service resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Name, self_link or ID of the VPC network the forwarding rule of the gateway must be in, to tell apart gateways with the same name in clusters on different networks. Only internal load balancers have a network. Can only be set along with `gateway`.",
+				Optional:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the requests to follow through the URL map of the gateway, such as `/api/users`, to select the backend service it is routed to when the gateway has several routes. The route rules are evaluated by priority and the path rules by the longest match, like the load balancer does, although only their path conditions are checked. Can only be set along with `gateway`.",
+				Optional:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the Kubernetes service resource, only needed when the service is exposed on several ports, as GKE creates a backend service for each port. Along with `gateway`, selects the backend service of that port among the ones the gateway routes to, even without `service`.",
+				Optional:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"redirect_forwarding_rule": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"ip_address": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "IP address the forwarding rule serves on.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the forwarding rule.",
+					},
+					"port_range": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Port the forwarding rule serves on, such as `80-80`.",
+					},
+					"self_link": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "URI of the forwarding rule.",
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The forwarding rule redirecting HTTP to HTTPS that GKE creates for the gateways with a redirect listener, which is skipped to find the backend service, so firewall rules and monitors can cover that frontend too - will be null when the gateway has none, or when looking up a service.",
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"regions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The regions to search, `global` standing for the global load balancers, for fleets deploying the same gateway in several regions. The regions are searched concurrently, and the first one in the list where a backend service is found wins. Set to `[\"all\"]` to search every region and the global scope, when it isn't known whether the gateway class is regional or global, which finds the regions of the gateway in a single aggregated listing and searches them in alphabetical order. Conflicts with `region`.",
+				Optional:            true,
+			},
+			"route_rule_priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the route rule of the URL map of the gateway to select the backend service of, for when the rule is known in advance, as GKE gives each rule of the HTTPRoutes a stable priority. Can only be set along with `gateway`, and not along with `path`.",
+				Optional:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes service resource. At least one of `gateway` or `service` must be set. Along with `gateway`, selects the backend service of that service among the ones the gateway routes to.",
+				Optional:            true,
+			},
+			"subnetwork": schema.StringAttribute{
+				MarkdownDescription: "Name, self_link or ID of the subnetwork the forwarding rule of the gateway must be in. Only internal load balancers have a subnetwork. Can only be set along with `gateway`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": dataSourceTimeoutsBlock(),
+			"wait": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"poll_interval": schema.StringAttribute{
+						MarkdownDescription: "How long to wait between two lookups, as a duration such as `10s`. Defaults to `10s`.",
+						Optional:            true,
+					},
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "How long to wait for the backend service before failing, as a duration such as `15m`. Defaults to `10m`.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "Waits for GKE to program the load balancer, which takes several minutes after a Gateway is created, instead of finding nothing on the first apply. The lookup is repeated until the forwarding rule exists along with the target proxy, URL map and backend service it references, or the timeout expires. If it is not provided, the lookup is made once.",
+			},
+		},
+		MarkdownDescription: "Finds the backend service details for the load balancer created from a Kubernetes Gateway resource by GKE. When looking up by `gateway`, this assumes the Gateway only has one Service, unless `disambiguation` says how to pick among several, but is untested with multiple HTTPRoutes. Looking up by `service` instead finds the backend service GKE created for that Service, whichever gateway routes to it.",
 	}
+}
 
-	// region can be set at the provider, data source level, or not at all.
-	if data.Region.IsUnknown() {
-		resp.Diagnostics.AddError("Unknown region", "The region field on the data source cannot be set to an unknown value")
+func (d *BackendServiceDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data BackendServiceDataSourceModel
+
+	// Every attribute of the model can hold an unknown value, so values only known at apply time, such as a gateway
+	// named after another resource or regions passed from another module, decode and only skip their own checks.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	region := d.providerData.region
-	if !data.Region.IsNull() {
-		region = data.Region
+	validateBackendServiceLookup(ctx, &data, &resp.Diagnostics)
+}
+
+// find looks up the backend services, repeating the lookup until one is found when the wait block is set. When nothing
+// is found, the returned string describes what is missing.
+func (d *BackendServiceDataSource) find(ctx context.Context, project string, region types.String, data *BackendServiceDataSourceModel) ([]*computepb.BackendService, string, diag.Diagnostics) {
+	if data.Wait == nil {
+		return d.lookupRegions(ctx, project, region, data)
 	}
 
-	// Loop over the forwarding rules.
-	var forwardingRulesIterator *compute.ForwardingRuleIterator
-	if region.IsNull() {
-		forwardingRulesIterator = d.providerData.globalForwardingRulesClient.List(ctx, &computepb.ListGlobalForwardingRulesRequest{
-			Project: project,
-		})
-	} else {
-		forwardingRulesIterator = d.providerData.forwardingRulesClient.List(ctx, &computepb.ListForwardingRulesRequest{
-			Project: project,
-			Region:  region.ValueString(),
-		})
+	timeout, pollInterval, diags := parseBackendServiceWait(data.Wait)
+
+	if diags.HasError() {
+		return nil, "", diags
 	}
 
-	matchingForwardingRules := make([]*computepb.ForwardingRule, 0)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	for {
-		forwardingRule, err := forwardingRulesIterator.Next()
+		// Only the diagnostics of the last lookup are kept, so the warnings of each attempt aren't repeated.
+		backendServices, missing, lookupDiags := d.lookupRegions(ctx, project, region, data)
 
-		if err == iterator.Done {
-			break
+		if lookupDiags.HasError() || len(backendServices) > 0 {
+			return backendServices, missing, lookupDiags
 		}
 
-		if err != nil {
-			// Ignore 404 errors for projects that don't exist yet.
-			if e, ok := err.(*apierror.APIError); ok && e.HTTPCode() == 404 {
-				return
+		select {
+		case <-ctx.Done():
+			lookupDiags.AddError("Timed out waiting for backend service", fmt.Sprintf("%s Waited for %s.", missing, timeout))
+			return nil, missing, lookupDiags
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// lookup resolves the backend services of the gateway or the service once, narrowed down by the disambiguation. The
+// forwarding rule must reference an existing target proxy, which must reference an existing URL map and so on, so once
+// GKE created the forwarding rule the rest of the load balancer exists.
+func (d *BackendServiceDataSource) lookup(ctx context.Context, project string, region types.String, data *BackendServiceDataSourceModel) ([]*computepb.BackendService, string, diag.Diagnostics) {
+	data.DescriptionFormat = types.StringNull()
+	data.ForwardingRuleName = types.StringNull()
+	data.ForwardingRuleSelfLink = types.StringNull()
+	data.RedirectForwardingRule = nil
+
+	// Without a disambiguation, several matching backend services are an error listing them.
+	disambiguation := data.Disambiguation.ValueString()
+	strict := disambiguation == "" || disambiguation == "error"
+
+	if data.Gateway.IsNull() {
+		var (
+			backendServices []*computepb.BackendService
+			diags           diag.Diagnostics
+		)
+
+		if strict {
+			var backendService *computepb.BackendService
+
+			backendService, diags = d.providerData.lookupServiceBackendService(ctx, project, region, data.Namespace.ValueString(), data.Service.ValueString(), data.Port.ValueInt64())
+
+			if backendService != nil {
+				backendServices = []*computepb.BackendService{backendService}
 			}
+		} else {
+			backendServices, diags = d.providerData.findServiceBackendServices(ctx, project, region, data.Namespace.ValueString(), data.Service.ValueString(), data.Port.ValueInt64())
+		}
 
-			resp.Diagnostics.AddError("Unable to iterate over forwarding rules", fmt.Sprintf("Error calling Google API: %+v", err))
-			return
+		if diags.HasError() {
+			return nil, "", diags
 		}
 
-		// Most rules won't have a JSON description.
-		frd := forwardingRuleDescription{}
-		if err := json.Unmarshal([]byte(forwardingRule.GetDescription()), &frd); err == nil {
-			if frd.K8sResource != nil && *frd.K8sResource == fmt.Sprintf("/namespaces/%s/gateways/%s", data.Namespace.ValueString(), data.Gateway.ValueString()) {
-				matchingForwardingRules = append(matchingForwardingRules, forwardingRule)
-			}
+		if len(backendServices) == 0 {
+			return nil, fmt.Sprintf("No backend service in project %s was created for service %s/%s.", project, data.Namespace.ValueString(), data.Service.ValueString()), diags
 		}
+
+		return disambiguateBackendServices(backendServices, disambiguation), "", diags
 	}
 
-	if len(matchingForwardingRules) == 0 {
-		return
-	} else if len(matchingForwardingRules) > 1 {
-		debugMessage := "The following forwarding rules matched:\n\n"
-		for _, rule := range matchingForwardingRules {
-			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, rule.GetName())
+	forwardingRules, diags := d.providerData.findKindForwardingRules(ctx, project, region, resourceKind(data.Kind), data.Namespace.ValueString(), data.Gateway.ValueString(), data.GatewayUID.ValueString())
+
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	forwardingRules, redirectForwardingRules, redirectDiags := d.providerData.splitRedirectForwardingRules(ctx, project, region, filterForwardingRulesByNetwork(forwardingRules, data.Network, data.Subnetwork))
+	diags.Append(redirectDiags...)
+
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	// Gateways have at most one HTTP listener redirecting to HTTPS.
+	if len(redirectForwardingRules) > 0 {
+		data.RedirectForwardingRule = &BackendServiceDataSourceModelForwardingRule{
+			IPAddress: types.StringValue(redirectForwardingRules[0].GetIPAddress()),
+			Name:      types.StringValue(redirectForwardingRules[0].GetName()),
+			PortRange: types.StringValue(redirectForwardingRules[0].GetPortRange()),
+			SelfLink:  types.StringValue(redirectForwardingRules[0].GetSelfLink()),
 		}
+	}
 
-		resp.Diagnostics.AddError("Multiple matching forwarding rules found", debugMessage)
-		return
+	forwardingRule, ruleDiags := singleForwardingRule(forwardingRules)
+	diags.Append(ruleDiags...)
+
+	if diags.HasError() {
+		return nil, "", diags
 	}
 
-	// Lookup the target.
-	targetComponents := strings.Split(matchingForwardingRules[0].GetTarget(), "/")
+	if forwardingRule == nil {
+		return nil, fmt.Sprintf("No forwarding rule in project %s references %s.", project, describeGateway(data)), diags
+	}
 
-	var urlMapResource string
+	// The rule was already recognized when listed, so this only recovers the format it was recognized by.
+	gfr, _, _ := d.providerData.parseGatewayForwardingRule(forwardingRule)
 
-	switch targetComponents[len(targetComponents)-2] {
-	case "targetHttpsProxies":
-		var proxy *computepb.TargetHttpsProxy
+	data.DescriptionFormat = types.StringValue(gfr.format)
+	data.ForwardingRuleName = types.StringValue(forwardingRule.GetName())
+	data.ForwardingRuleSelfLink = types.StringValue(forwardingRule.GetSelfLink())
 
-		if region.IsNull() {
-			proxy, err = d.providerData.targetHttpsProxiesClient.Get(ctx, &computepb.GetTargetHttpsProxyRequest{
-				Project:          project,
-				TargetHttpsProxy: targetComponents[len(targetComponents)-1],
-			})
-		} else {
-			proxy, err = d.providerData.regionTargetHttpsProxiesClient.Get(ctx, &computepb.GetRegionTargetHttpsProxyRequest{
-				Project:          project,
-				Region:           region.ValueString(),
-				TargetHttpsProxy: targetComponents[len(targetComponents)-1],
-			})
-		}
+	route := urlMapRoute{
+		hostname: data.Hostname.ValueString(),
+		path:     data.Path.ValueString(),
+		priority: data.RouteRulePriority.ValueInt64Pointer(),
+	}
 
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error looking up HTTPS target proxy %s", targetComponents[len(targetComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
-			return
+	// Without a service or port to narrow them down, the backend service is resolved the way the other lookups do.
+	if strict && data.Service.IsNull() && data.Port.IsNull() {
+		backendService, backendServiceDiags := d.providerData.forwardingRuleBackendService(ctx, project, region, forwardingRule, route)
+		diags.Append(backendServiceDiags...)
+
+		if diags.HasError() {
+			return nil, "", diags
 		}
 
-		urlMapResource = proxy.GetUrlMap()
-	default:
-		resp.Diagnostics.AddError("Unsupported target type for forwarding rule", fmt.Sprintf("The %s forwarding rule has a target with a type of %s which is currently unsupported by this provider.", matchingForwardingRules[0].GetName(), targetComponents[len(targetComponents)-2]))
-		return
+		return []*computepb.BackendService{backendService}, "", diags
 	}
 
-	// Lookup the URL map.
-	urlMapComponents := strings.Split(urlMapResource, "/")
+	backendServicePaths, pathDiags := d.providerData.forwardingRuleBackendServicePaths(ctx, project, region, forwardingRule, route)
+	diags.Append(pathDiags...)
 
-	var urlMap *computepb.UrlMap
-	if region.IsNull() {
-		urlMap, err = d.providerData.urlMapsClient.Get(ctx, &computepb.GetUrlMapRequest{
-			Project: project,
-			UrlMap:  urlMapComponents[len(urlMapComponents)-1],
-		})
-	} else {
-		urlMap, err = d.providerData.regionUrlMapsClient.Get(ctx, &computepb.GetRegionUrlMapRequest{
-			Project: project,
-			Region:  region.ValueString(),
-			UrlMap:  urlMapComponents[len(urlMapComponents)-1],
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	if len(backendServicePaths) == 0 {
+		diags.AddError("No backend services found", noBackendServicesDetail(forwardingRule, route))
+		return nil, "", diags
+	}
+
+	backendServices, backendServicesDiags := d.providerData.getBackendServices(ctx, project, region, backendServicePaths)
+	diags.Append(backendServicesDiags...)
+
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	if !data.Service.IsNull() || !data.Port.IsNull() {
+		backendServices = slices.DeleteFunc(backendServices, func(backendService *computepb.BackendService) bool {
+			return !backendServiceMatchesService(backendService, data.Namespace.ValueString(), data.Service.ValueString(), data.Port.ValueInt64())
 		})
+
+		if len(backendServices) == 0 {
+			target := fmt.Sprintf("service %s/%s", data.Namespace.ValueString(), data.Service.ValueString())
+			if data.Service.IsNull() {
+				target = fmt.Sprintf("port %d", data.Port.ValueInt64())
+			} else if !data.Port.IsNull() {
+				target = fmt.Sprintf("port %d of %s", data.Port.ValueInt64(), target)
+			}
+
+			return nil, fmt.Sprintf("No backend service behind %s was created for %s.", describeGateway(data), target), diags
+		}
 	}
 
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error looking up URL map %s", urlMapComponents[len(urlMapComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
-		return
+	if strict && len(backendServices) > 1 {
+		debugMessage := "The following backend services matched, use port or disambiguation to select one:\n\n"
+		for _, backendService := range backendServices {
+			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, backendService.GetName())
+		}
+
+		diags.AddError("Multiple backend services found", debugMessage)
+		return nil, "", diags
+	}
+
+	return disambiguateBackendServices(backendServices, disambiguation), "", diags
+}
+
+// lookupRegions looks up the backend services once, in each of the regions when several are searched. The regions are
+// searched concurrently, and the first one in the order given where some are found wins. With `all`, the regions the
+// gateway has forwarding rules in are found first, and searched in alphabetical order.
+func (d *BackendServiceDataSource) lookupRegions(ctx context.Context, project string, region types.String, data *BackendServiceDataSourceModel) ([]*computepb.BackendService, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data.MatchedRegion = types.StringNull()
+
+	if data.Regions.IsNull() {
+		return d.lookup(ctx, project, region, data)
+	}
+
+	type regionLookup struct {
+		backendServices []*computepb.BackendService
+		data            BackendServiceDataSourceModel
+		missing         string
 	}
 
-	// Prase the URL map to determine eligible backend services
-	backendServicePaths := []*string{}
-	routeActions := []*computepb.HttpRouteAction{
-		urlMap.DefaultRouteAction,
+	regionNames := []types.String{}
+	diags.Append(data.Regions.ElementsAs(ctx, &regionNames, false)...)
+
+	if diags.HasError() {
+		return nil, "", diags
 	}
 
-	if urlMap.DefaultService != nil {
-		backendServicePaths = append(backendServicePaths, urlMap.DefaultService)
+	names := []string{}
+	for _, name := range regionNames {
+		names = append(names, name.ValueString())
 	}
 
-	for _, matcher := range urlMap.PathMatchers {
-		routeActions = append(routeActions, matcher.DefaultRouteAction)
+	if slices.Equal(names, []string{"all"}) {
+		regions, regionsDiags := d.providerData.findGatewayForwardingRuleRegions(ctx, project, resourceKind(data.Kind), data.Namespace.ValueString(), data.Gateway.ValueString(), data.GatewayUID.ValueString())
+		diags.Append(regionsDiags...)
+
+		if diags.HasError() {
+			return nil, "", diags
+		}
 
-		if matcher.DefaultService != nil {
-			backendServicePaths = append(backendServicePaths, matcher.DefaultService)
+		if len(regions) == 0 {
+			return nil, fmt.Sprintf("No forwarding rule in project %s references %s in any region.", project, describeGateway(data)), diags
 		}
 
-		for _, rule := range matcher.RouteRules {
-			routeActions = append(routeActions, rule.RouteAction)
+		names = []string{}
+		for _, region := range regions {
+			if region.IsNull() {
+				names = append(names, "global")
+			} else {
+				names = append(names, region.ValueString())
+			}
 		}
+
+		slices.Sort(names)
 	}
 
-	for _, action := range routeActions {
-		if action == nil || action.FaultInjectionPolicy != nil {
-			continue
+	results := lookupConcurrently(ctx, names, func(ctx context.Context, name string) (regionLookup, diag.Diagnostics) {
+		scope := types.StringValue(name)
+		if name == "global" {
+			scope = types.StringNull()
 		}
 
-		for _, wbs := range action.WeightedBackendServices {
-			backendServicePaths = append(backendServicePaths, wbs.BackendService)
+		// Each lookup fills in the computed attributes of its own copy.
+		regionData := *data
+		backendServices, missing, diags := d.lookup(ctx, project, scope, &regionData)
+
+		return regionLookup{
+			backendServices: backendServices,
+			data:            regionData,
+			missing:         missing,
+		}, diags
+	})
+
+	for _, name := range names {
+		result := results[name]
+		diags.Append(result.diags...)
+
+		if diags.HasError() {
+			return nil, "", diags
 		}
-	}
 
-	if len(backendServicePaths) == 0 {
-		resp.Diagnostics.AddError("No backend services found", "")
-		return
-	} else if len(backendServicePaths) > 1 {
-		debugMessage := "The following backend services matched:\n\n"
-		for _, path := range backendServicePaths {
-			components := strings.Split(*path, "/")
-			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, components[len(components)-1])
+		if len(result.value.backendServices) > 0 {
+			*data = result.value.data
+			data.MatchedRegion = types.StringValue(name)
+
+			return result.value.backendServices, "", diags
 		}
+	}
 
-		resp.Diagnostics.AddError("Multiple backend services found", debugMessage)
-		return
+	return nil, fmt.Sprintf("%s Searched the %s regions.", results[names[0]].value.missing, strings.Join(names, ", ")), diags
+}
+
+// read looks up the backend service of the configuration, filling in the computed attributes of the model. Nothing is
+// filled in when no backend service is found, unless fail_if_missing is set.
+func (d *BackendServiceDataSource) read(ctx context.Context, data *BackendServiceDataSourceModel) diag.Diagnostics {
+	ctx, cancel, diags := withReadTimeout(ctx, data.Timeouts)
+	defer cancel()
+
+	if diags.HasError() {
+		return diags
 	}
 
-	// Finally, lookup the backend service.
-	backendServiceComponents := strings.Split(*backendServicePaths[0], "/")
+	project, region, scopeDiags := d.providerData.resolveScope(data.Project, data.Region)
+	diags.Append(scopeDiags...)
 
-	var backendService *computepb.BackendService
-	if region.IsNull() {
-		backendService, err = d.providerData.backendServicesClient.Get(ctx, &computepb.GetBackendServiceRequest{
-			BackendService: backendServiceComponents[len(backendServiceComponents)-1],
-			Project:        project,
-		})
-	} else {
-		backendService, err = d.providerData.regionBackendServicesClient.Get(ctx, &computepb.GetRegionBackendServiceRequest{
-			BackendService: backendServiceComponents[len(backendServiceComponents)-1],
-			Region:         region.ValueString(),
-			Project:        project,
-		})
+	if diags.HasError() {
+		return diags
 	}
 
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error looking up backend service %s", backendServiceComponents[len(backendServiceComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
-		return
+	backendServices, missing, findDiags := d.find(ctx, project, region, data)
+	diags.Append(findDiags...)
+
+	if diags.HasError() {
+		return diags
 	}
 
-	// Save data into Terraform state
-	data.BackendService = &BackendServiceDataSourceModelBackendService{
-		ID:   types.StringValue(strconv.FormatUint(backendService.GetId(), 10)),
-		Name: types.StringValue(backendService.GetName()),
+	if len(backendServices) == 0 {
+		if data.FailIfMissing.ValueBool() {
+			diags.AddError("Backend service not found", missing)
+		}
+
+		return diags
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if len(backendServices) == 1 {
+		data.BackendService = backendServiceModel(backendServices[0])
+	}
+
+	if data.Disambiguation.ValueString() == "all" {
+		data.BackendServices = []BackendServiceDataSourceModelBackendService{}
+
+		for _, backendService := range backendServices {
+			data.BackendServices = append(data.BackendServices, *backendServiceModel(backendService))
+		}
+	}
+
+	return diags
 }
 
-func (d *BackendServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"backend_service": schema.SingleNestedAttribute{
+// backendServiceAttributes returns the schema of the backend service attributes of the data source.
+func backendServiceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"affinity_cookie_ttl_sec": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "How long, in seconds, the session affinity cookie is valid for - `0` means it lasts for the browser session.",
+		},
+		"backends": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
-					"id": schema.StringAttribute{
+					"balancing_mode": schema.StringAttribute{
 						Computed:            true,
-						MarkdownDescription: "Identifier for the backend service with format `projects/{{project}}/global/backendServices/{{name}}` or `projects/{{project}}/regions/{{region}}/backendServices/{{name}}`.",
+						MarkdownDescription: "How the load balancer measures the capacity of the backend, such as `RATE` or `CONNECTION`.",
 					},
-					"name": schema.StringAttribute{
+					"capacity_scaler": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The fraction of the capacity of the backend that is used, between `0` and `1`.",
+					},
+					"group": schema.StringAttribute{
 						Computed:            true,
-						MarkdownDescription: "Name of the backend service.",
+						MarkdownDescription: "URI of the network endpoint group of the backend.",
 					},
 				},
-				Computed:            true,
-				MarkdownDescription: "Details about the backend service - will be null if none is found.",
 			},
-			"gateway": schema.StringAttribute{
-				MarkdownDescription: "Name of the Kubernetes gateway resource.",
-				Required:            true,
+			MarkdownDescription: "The backends of the backend service, one per zonal network endpoint group.",
+		},
+		"cdn_policy": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"cache_mode": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "What Cloud CDN caches, one of `USE_ORIGIN_HEADERS`, `FORCE_CACHE_ALL` or `CACHE_ALL_STATIC`.",
+				},
+				"client_ttl": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum TTL, in seconds, sent to clients for cached content.",
+				},
+				"default_ttl": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The TTL, in seconds, of cached content the origin gives no TTL for.",
+				},
+				"max_ttl": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum TTL, in seconds, of cached content.",
+				},
+				"negative_caching": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Whether error responses, such as `404`, are cached.",
+				},
+				"negative_caching_policy": schema.ListNestedAttribute{
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"code": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "The HTTP status code of the error response.",
+							},
+							"ttl": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "The TTL, in seconds, of the cached error response.",
+							},
+						},
+					},
+					MarkdownDescription: "The TTL of each cached error response, overriding the default ones.",
+				},
 			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
-				Required:            true,
+			Computed:            true,
+			MarkdownDescription: "The Cloud CDN settings of the backend service, set through a `GCPBackendPolicy`, with each TTL null when unset - will be null if they were never configured.",
+		},
+		"circuit_breakers": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"max_connections": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum number of connections to the backends.",
+				},
+				"max_pending_requests": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum number of requests waiting for a connection to the backends.",
+				},
+				"max_requests": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum number of parallel requests to the backends.",
+				},
+				"max_requests_per_connection": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum number of requests over a single connection to a backend.",
+				},
+				"max_retries": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum number of parallel retries to the backends.",
+				},
 			},
-			"project": schema.StringAttribute{
-				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
-				Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The circuit breakers of the backend service, with each field null when unset - will be null if none are configured.",
+		},
+		"connection_draining_timeout_sec": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "How long, in seconds, the load balancer lets in-flight requests complete on an endpoint being removed, such as during a rollout.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description GKE wrote on the backend service, a JSON document referencing the Kubernetes service.",
+		},
+		"enable_cdn": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether Cloud CDN is enabled on the backend service.",
+		},
+		"fingerprint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The fingerprint of the backend service, which changes every time it is updated.",
+		},
+		"iap": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Whether Identity-Aware Proxy is enabled on the backend service.",
+				},
+				"oauth2_client_id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The OAuth2 client ID IAP uses - will be null when the Google-managed client is used.",
+				},
 			},
-			"region": schema.StringAttribute{
-				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
-				Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The Identity-Aware Proxy settings of the backend service, set through a `GCPBackendPolicy` - will be null if they were never configured.",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Identifier for the backend service with format `projects/{{project}}/global/backendServices/{{name}}` or `projects/{{project}}/regions/{{region}}/backendServices/{{name}}`.",
+		},
+		"load_balancing_scheme": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The load balancing scheme of the backend service, such as `EXTERNAL_MANAGED` or `INTERNAL_MANAGED`.",
+		},
+		"locality_lb_policy": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How the load balancer spreads requests across the endpoints of a zone, such as `ROUND_ROBIN` or `LEAST_REQUEST` - will be empty when the default is used.",
+		},
+		"log_config": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"enable": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Whether access logging is enabled.",
+				},
+				"optional_fields": schema.ListAttribute{
+					Computed:            true,
+					ElementType:         types.StringType,
+					MarkdownDescription: "The optional fields logged when `optional_mode` is `CUSTOM`.",
+				},
+				"optional_mode": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Which optional fields are logged, one of `INCLUDE_ALL_OPTIONAL`, `EXCLUDE_ALL_OPTIONAL` or `CUSTOM`.",
+				},
+				"sample_rate": schema.Float64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The fraction of requests that are logged, between `0` and `1`.",
+				},
+			},
+			Computed:            true,
+			MarkdownDescription: "The access logging configuration of the backend service, set through a `GCPBackendPolicy` - will be null if it was never configured.",
+		},
+		"max_stream_duration": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The maximum duration of a stream, such as a long-lived gRPC call, before it is closed - will be null if unlimited.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Name of the backend service.",
+		},
+		"outlier_detection": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"base_ejection_time": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "How long an endpoint is ejected for the first time, growing with each ejection.",
+				},
+				"consecutive_errors": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The number of consecutive errors after which an endpoint is ejected.",
+				},
+				"consecutive_gateway_failure": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The number of consecutive gateway failures, such as `502` or `503` responses, after which an endpoint is ejected.",
+				},
+				"enforcing_consecutive_errors": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The percentage chance an endpoint is ejected after `consecutive_errors`.",
+				},
+				"enforcing_consecutive_gateway_failure": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The percentage chance an endpoint is ejected after `consecutive_gateway_failure`.",
+				},
+				"enforcing_success_rate": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The percentage chance an endpoint is ejected based on its success rate.",
+				},
+				"interval": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "How often endpoints are analyzed for ejection.",
+				},
+				"max_ejection_percent": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum percentage of endpoints that can be ejected.",
+				},
+				"success_rate_minimum_hosts": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The number of endpoints needed to detect outliers based on their success rate.",
+				},
+				"success_rate_request_volume": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The number of requests an endpoint must receive over an interval to be included in the success rate analysis.",
+				},
+				"success_rate_stdev_factor": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The factor, divided by a thousand, of the standard deviation under which an endpoint success rate is an outlier.",
+				},
+			},
+			Computed:            true,
+			MarkdownDescription: "The outlier detection settings of the backend service, with each field null when unset - will be null if none are configured.",
+		},
+		"port_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The named port of the instance groups the load balancer sends traffic to - will be empty for network endpoint groups, which carry their own ports.",
+		},
+		"protocol": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The protocol the load balancer uses to talk to the backends, such as `HTTP`, `HTTPS`, `HTTP2` or `H2C`, inferred by GKE from the `appProtocol` of the Service port.",
+		},
+		"security_policy": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Name of the Cloud Armor security policy.",
+				},
+				"self_link": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "URI of the Cloud Armor security policy.",
+				},
 			},
+			Computed:            true,
+			MarkdownDescription: "The Cloud Armor security policy attached to the backend service, through a `GCPBackendPolicy` - will be null if none is attached.",
+		},
+		"self_link": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "URI of the backend service.",
 		},
-		MarkdownDescription: "Finds the backend service details for the load balancer created from a Kubernetes Gateway resource by GKE. This assumes the Gateway only has one Service but is untested with multiple HTTPRoutes.",
+		"session_affinity": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How requests of a client stick to an endpoint, such as `NONE`, `CLIENT_IP` or `GENERATED_COOKIE`.",
+		},
+		"timeout_sec": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "How long, in seconds, the load balancer waits for a backend to respond.",
+		},
+	}
+}
+
+// validateBackendServiceLookup validates the combination of the lookup attributes, shared by the data source and the
+// ephemeral resource.
+func validateBackendServiceLookup(ctx context.Context, data *BackendServiceDataSourceModel, diags *diag.Diagnostics) {
+	if data.Gateway.IsNull() && data.Service.IsNull() {
+		diags.AddAttributeError(path.Root("service"), "Invalid Attribute Combination", "At least one of gateway or service must be set.")
+	}
+
+	if !data.Port.IsNull() && !data.Port.IsUnknown() && (data.Port.ValueInt64() < 1 || data.Port.ValueInt64() > 65535) {
+		diags.AddAttributeError(path.Root("port"), "Invalid port", "The port must be between 1 and 65535.")
+	}
+
+	if !data.Network.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("network"), "Invalid Attribute Combination", "The network can only be set along with gateway.")
+	}
+
+	if !data.Subnetwork.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("subnetwork"), "Invalid Attribute Combination", "The subnetwork can only be set along with gateway.")
+	}
+
+	if !data.Hostname.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("hostname"), "Invalid Attribute Combination", "The hostname can only be set along with gateway.")
+	}
+
+	if !data.Path.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("path"), "Invalid Attribute Combination", "The path can only be set along with gateway.")
+	}
+
+	if !data.Path.IsNull() && !data.Path.IsUnknown() && !strings.HasPrefix(data.Path.ValueString(), "/") {
+		diags.AddAttributeError(path.Root("path"), "Invalid path", "The path must start with `/`.")
+	}
+
+	if !data.Regions.IsNull() && !data.Region.IsNull() {
+		diags.AddAttributeError(path.Root("regions"), "Invalid Attribute Combination", "Only one of region or regions can be set.")
+	}
+
+	// The regions are only checked once known, a list passed from another resource may be unknown until apply.
+	regions := []types.String{}
+	if !data.Regions.IsNull() && !data.Regions.IsUnknown() {
+		diags.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+
+		if len(regions) == 0 {
+			diags.AddAttributeError(path.Root("regions"), "Invalid regions", "The regions must list at least one region.")
+		}
+	}
+
+	for _, name := range regions {
+		if name.ValueString() == "all" && (len(regions) > 1 || data.Gateway.IsNull()) {
+			diags.AddAttributeError(path.Root("regions"), "Invalid regions", "The all region can only be set alone, along with gateway.")
+		}
+
+		if !name.IsUnknown() && (name.IsNull() || name.ValueString() == "") {
+			diags.AddAttributeError(path.Root("regions"), "Invalid regions", "The regions cannot be null or empty.")
+		}
+	}
+
+	if !data.RouteRulePriority.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("route_rule_priority"), "Invalid Attribute Combination", "The route_rule_priority can only be set along with gateway.")
+	}
+
+	if !data.RouteRulePriority.IsNull() && !data.Path.IsNull() {
+		diags.AddAttributeError(path.Root("route_rule_priority"), "Invalid Attribute Combination", "Only one of path or route_rule_priority can be set.")
+	}
+
+	if !data.RouteRulePriority.IsNull() && !data.RouteRulePriority.IsUnknown() && (data.RouteRulePriority.ValueInt64() < 0 || data.RouteRulePriority.ValueInt64() > 2147483647) {
+		diags.AddAttributeError(path.Root("route_rule_priority"), "Invalid route_rule_priority", "The route_rule_priority must be between 0 and 2147483647.")
+	}
+
+	if !data.Kind.IsNull() && !data.Kind.IsUnknown() && !slices.Contains([]string{"gateway", "ingress"}, data.Kind.ValueString()) {
+		diags.AddAttributeError(path.Root("kind"), "Invalid kind", fmt.Sprintf("The kind must be one of `gateway` or `ingress`, got `%s`.", data.Kind.ValueString()))
+	}
+
+	if !data.GatewayUID.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("gateway_uid"), "Invalid Attribute Combination", "The gateway_uid can only be set along with gateway.")
+	}
+
+	if !data.Kind.IsNull() && data.Gateway.IsNull() {
+		diags.AddAttributeError(path.Root("kind"), "Invalid Attribute Combination", "The kind can only be set along with gateway.")
+	}
+
+	if !data.Disambiguation.IsNull() && !data.Disambiguation.IsUnknown() && !slices.Contains([]string{"alphabetical", "all", "error", "newest"}, data.Disambiguation.ValueString()) {
+		diags.AddAttributeError(path.Root("disambiguation"), "Invalid disambiguation", fmt.Sprintf("The disambiguation must be one of `error`, `newest`, `alphabetical` or `all`, got `%s`.", data.Disambiguation.ValueString()))
+	}
+
+	if data.Timeouts != nil && !data.Timeouts.Read.IsNull() && !data.Timeouts.Read.IsUnknown() {
+		_, timeoutDiags := parseReadTimeout(data.Timeouts.Read)
+		diags.Append(timeoutDiags...)
+	}
+
+	if data.Wait != nil && !data.Wait.PollInterval.IsUnknown() && !data.Wait.Timeout.IsUnknown() {
+		_, _, waitDiags := parseBackendServiceWait(data.Wait)
+		diags.Append(waitDiags...)
+	}
+}
+
+// backendServiceModel converts the backend service into the attributes of the data source.
+func backendServiceModel(backendService *computepb.BackendService) *BackendServiceDataSourceModelBackendService {
+	model := &BackendServiceDataSourceModelBackendService{
+		AffinityCookieTTLSec:         types.Int64Value(int64(backendService.GetAffinityCookieTtlSec())),
+		Backends:                     []BackendServiceDataSourceModelBackend{},
+		ConnectionDrainingTimeoutSec: types.Int64Value(int64(backendService.GetConnectionDraining().GetDrainingTimeoutSec())),
+		Description:                  types.StringValue(backendService.GetDescription()),
+		EnableCDN:                    types.BoolValue(backendService.GetEnableCDN()),
+		Fingerprint:                  types.StringValue(backendService.GetFingerprint()),
+		ID:                           types.StringValue(strconv.FormatUint(backendService.GetId(), 10)),
+		LoadBalancingScheme:          types.StringValue(backendService.GetLoadBalancingScheme()),
+		LocalityLBPolicy:             types.StringValue(backendService.GetLocalityLbPolicy()),
+		MaxStreamDuration:            formatComputeDuration(backendService.GetMaxStreamDuration()),
+		Name:                         types.StringValue(backendService.GetName()),
+		PortName:                     types.StringValue(backendService.GetPortName()),
+		Protocol:                     types.StringValue(backendService.GetProtocol()),
+		SelfLink:                     types.StringValue(backendService.GetSelfLink()),
+		SessionAffinity:              types.StringValue(backendService.GetSessionAffinity()),
+		TimeoutSec:                   types.Int64Value(int64(backendService.GetTimeoutSec())),
+	}
+
+	for _, backend := range backendService.GetBackends() {
+		model.Backends = append(model.Backends, BackendServiceDataSourceModelBackend{
+			BalancingMode:  types.StringValue(backend.GetBalancingMode()),
+			CapacityScaler: types.Float64Value(float64(backend.GetCapacityScaler())),
+			Group:          types.StringValue(backend.GetGroup()),
+		})
+	}
+
+	if cdnPolicy := backendService.GetCdnPolicy(); cdnPolicy != nil {
+		model.CDNPolicy = &BackendServiceDataSourceModelCDNPolicy{
+			CacheMode:             types.StringValue(cdnPolicy.GetCacheMode()),
+			ClientTTL:             int32Value(cdnPolicy.ClientTtl),
+			DefaultTTL:            int32Value(cdnPolicy.DefaultTtl),
+			MaxTTL:                int32Value(cdnPolicy.MaxTtl),
+			NegativeCaching:       types.BoolValue(cdnPolicy.GetNegativeCaching()),
+			NegativeCachingPolicy: []BackendServiceDataSourceModelNegativeCachingTTL{},
+		}
+
+		for _, policy := range cdnPolicy.GetNegativeCachingPolicy() {
+			model.CDNPolicy.NegativeCachingPolicy = append(model.CDNPolicy.NegativeCachingPolicy, BackendServiceDataSourceModelNegativeCachingTTL{
+				Code: int32Value(policy.Code),
+				TTL:  int32Value(policy.Ttl),
+			})
+		}
+	}
+
+	if circuitBreakers := backendService.GetCircuitBreakers(); circuitBreakers != nil {
+		model.CircuitBreakers = &BackendServiceDataSourceModelCircuitBreakers{
+			MaxConnections:           int32Value(circuitBreakers.MaxConnections),
+			MaxPendingRequests:       int32Value(circuitBreakers.MaxPendingRequests),
+			MaxRequests:              int32Value(circuitBreakers.MaxRequests),
+			MaxRequestsPerConnection: int32Value(circuitBreakers.MaxRequestsPerConnection),
+			MaxRetries:               int32Value(circuitBreakers.MaxRetries),
+		}
+	}
+
+	if outlierDetection := backendService.GetOutlierDetection(); outlierDetection != nil {
+		model.OutlierDetection = &BackendServiceDataSourceModelOutlierDetection{
+			BaseEjectionTime:                   formatComputeDuration(outlierDetection.GetBaseEjectionTime()),
+			ConsecutiveErrors:                  int32Value(outlierDetection.ConsecutiveErrors),
+			ConsecutiveGatewayFailure:          int32Value(outlierDetection.ConsecutiveGatewayFailure),
+			EnforcingConsecutiveErrors:         int32Value(outlierDetection.EnforcingConsecutiveErrors),
+			EnforcingConsecutiveGatewayFailure: int32Value(outlierDetection.EnforcingConsecutiveGatewayFailure),
+			EnforcingSuccessRate:               int32Value(outlierDetection.EnforcingSuccessRate),
+			Interval:                           formatComputeDuration(outlierDetection.GetInterval()),
+			MaxEjectionPercent:                 int32Value(outlierDetection.MaxEjectionPercent),
+			SuccessRateMinimumHosts:            int32Value(outlierDetection.SuccessRateMinimumHosts),
+			SuccessRateRequestVolume:           int32Value(outlierDetection.SuccessRateRequestVolume),
+			SuccessRateStdevFactor:             int32Value(outlierDetection.SuccessRateStdevFactor),
+		}
+	}
+
+	if iap := backendService.GetIap(); iap != nil {
+		model.IAP = &BackendServiceDataSourceModelIAP{
+			Enabled:        types.BoolValue(iap.GetEnabled()),
+			Oauth2ClientID: types.StringNull(),
+		}
+
+		// The client is left empty when IAP uses the Google-managed OAuth client.
+		if iap.GetOauth2ClientId() != "" {
+			model.IAP.Oauth2ClientID = types.StringValue(iap.GetOauth2ClientId())
+		}
+	}
+
+	if securityPolicy := backendService.GetSecurityPolicy(); securityPolicy != "" {
+		securityPolicyComponents := strings.Split(securityPolicy, "/")
+
+		model.SecurityPolicy = &BackendServiceDataSourceModelSecurityPolicy{
+			Name:     types.StringValue(securityPolicyComponents[len(securityPolicyComponents)-1]),
+			SelfLink: types.StringValue(securityPolicy),
+		}
+	}
+
+	if logConfig := backendService.GetLogConfig(); logConfig != nil {
+		model.LogConfig = &BackendServiceDataSourceModelLogConfig{
+			Enable:         types.BoolValue(logConfig.GetEnable()),
+			OptionalFields: []types.String{},
+			OptionalMode:   types.StringValue(logConfig.GetOptionalMode()),
+			SampleRate:     types.Float64Value(float64(logConfig.GetSampleRate())),
+		}
+
+		for _, field := range logConfig.GetOptionalFields() {
+			model.LogConfig.OptionalFields = append(model.LogConfig.OptionalFields, types.StringValue(field))
+		}
+	}
+
+	return model
+}
+
+// disambiguateBackendServices narrows down the matching backend services following the disambiguation: `newest` keeps
+// the most recently created one, `alphabetical` the first by name and `all` keeps them all, sorted by name.
+func disambiguateBackendServices(backendServices []*computepb.BackendService, disambiguation string) []*computepb.BackendService {
+	sorted := slices.Clone(backendServices)
+
+	slices.SortFunc(sorted, func(a *computepb.BackendService, b *computepb.BackendService) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	switch disambiguation {
+	case "all":
+		return sorted
+	case "newest":
+		// The creation timestamps are RFC 3339, those that can't be parsed sort last.
+		slices.SortStableFunc(sorted, func(a *computepb.BackendService, b *computepb.BackendService) int {
+			aCreated, _ := time.Parse(time.RFC3339, a.GetCreationTimestamp())
+			bCreated, _ := time.Parse(time.RFC3339, b.GetCreationTimestamp())
+
+			return bCreated.Compare(aCreated)
+		})
+	}
+
+	return sorted[:1]
+}
+
+// int32Value converts an optional API number, which is null when unset.
+func int32Value(value *int32) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
 	}
+
+	return types.Int64Value(int64(*value))
+}
+
+// describeGateway names the Kubernetes resource the data source looks up in messages, such as `gateway my-app/my-gateway`.
+func describeGateway(data *BackendServiceDataSourceModel) string {
+	description := fmt.Sprintf("%s %s/%s", resourceKind(data.Kind), data.Namespace.ValueString(), data.Gateway.ValueString())
+	if !data.GatewayUID.IsNull() {
+		description += fmt.Sprintf(" with UID %s", data.GatewayUID.ValueString())
+	}
+
+	return description
+}
+
+// resourceKind returns the kind of Kubernetes resource the gateway attribute names, defaulting to a gateway.
+func resourceKind(kind types.String) string {
+	if kind.IsNull() {
+		return "gateway"
+	}
+
+	return kind.ValueString()
+}
+
+// parseBackendServiceWait parses the timeout and poll interval of the wait block, falling back to the defaults of the
+// wait_for_backend resource for what isn't set.
+func parseBackendServiceWait(wait *BackendServiceDataSourceModelWait) (time.Duration, time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timeout, timeoutDiags := parseWaitDuration(wait.Timeout, "timeout", 10*time.Minute)
+	diags.Append(timeoutDiags...)
+
+	pollInterval, pollIntervalDiags := parseWaitDuration(wait.PollInterval, "poll_interval", waitForBackendPollInterval)
+	diags.Append(pollIntervalDiags...)
+
+	return timeout, pollInterval, diags
+}
+
+// parseWaitDuration parses a duration of the wait block, falling back to the default when it isn't set.
+func parseWaitDuration(value types.String, attribute string, defaultDuration time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return defaultDuration, diags
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait").AtName(attribute), fmt.Sprintf("Invalid %s", attribute), fmt.Sprintf("The %s must be a duration such as `10s`: %s", attribute, err))
+		return 0, diags
+	}
+
+	if duration <= 0 {
+		diags.AddAttributeError(path.Root("wait").AtName(attribute), fmt.Sprintf("Invalid %s", attribute), fmt.Sprintf("The %s must be positive.", attribute))
+		return 0, diags
+	}
+
+	return duration, diags
 }
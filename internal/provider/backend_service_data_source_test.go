@@ -4,12 +4,91 @@
 package provider
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+// TestBackendServiceDataSourceValidateConfigUnknownRegions checks the regions can be passed from a resource, unknown
+// until apply, skipping only the checks of the regions.
+func TestBackendServiceDataSourceValidateConfigUnknownRegions(t *testing.T) {
+	ctx := context.Background()
+	d := &BackendServiceDataSource{}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	unknownRegions := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+	tests := map[string]struct {
+		values        map[string]tftypes.Value
+		expectedError string
+	}{
+		"gateway": {
+			values: map[string]tftypes.Value{
+				"gateway":   tftypes.NewValue(tftypes.String, "my-gateway-name"),
+				"namespace": tftypes.NewValue(tftypes.String, "my-cool-app"),
+				"regions":   unknownRegions,
+			},
+		},
+		// Known as ["all"], the regions would need a gateway.
+		"service": {
+			values: map[string]tftypes.Value{
+				"namespace": tftypes.NewValue(tftypes.String, "my-cool-app"),
+				"regions":   unknownRegions,
+				"service":   tftypes.NewValue(tftypes.String, "my-service"),
+			},
+		},
+		"region": {
+			values: map[string]tftypes.Value{
+				"gateway":   tftypes.NewValue(tftypes.String, "my-gateway-name"),
+				"namespace": tftypes.NewValue(tftypes.String, "my-cool-app"),
+				"region":    tftypes.NewValue(tftypes.String, "us-central1"),
+				"regions":   unknownRegions,
+			},
+			expectedError: "Only one of region or regions can be set.",
+		},
+		"invalid path": {
+			values: map[string]tftypes.Value{
+				"gateway":   tftypes.NewValue(tftypes.String, "my-gateway-name"),
+				"namespace": tftypes.NewValue(tftypes.String, "my-cool-app"),
+				"path":      tftypes.NewValue(tftypes.String, "api"),
+				"regions":   unknownRegions,
+			},
+			expectedError: "The path must start with `/`.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var resp datasource.ValidateConfigResponse
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, schemaResp.Schema.Type(), test.values),
+					Schema: schemaResp.Schema,
+				},
+			}, &resp)
+
+			if test.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Detail() != test.expectedError {
+				t.Fatalf("expected the error %q, got: %v", test.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestAccBackendServiceDataSourceValidations(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -24,7 +103,164 @@ func TestAccBackendServiceDataSourceValidations(t *testing.T) {
 						region    = "us-central1"
 					}
 				`,
-				ExpectError: regexp.MustCompile(`The argument "gateway" is required, but no definition was found.`),
+				ExpectError: regexp.MustCompile(`At least one of gateway or service must be set.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						port      = 80800
+						project   = "my-gcp-project"
+						region    = "us-central1"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The port must be between 1 and 65535.`),
+			},
+			{
+				Config: `
+					resource "terraform_data" "gateway" {
+						input = "my-gateway-name"
+					}
+
+					data "gkegateway_backend_service" "example" {
+						gateway   = terraform_data.gateway.output
+						namespace = "my-cool-app"
+						path      = "api/users"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The path must start with`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						namespace = "my-cool-app"
+						network   = "my-network"
+						project   = "my-gcp-project"
+						region    = "us-central1"
+						service   = "my-service"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The network can only be set along with gateway.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						hostname  = "www.example.com"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						service   = "my-service"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The hostname can only be set along with gateway.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						path      = "api/users"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile("The path must start with `/`."),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway             = "my-gateway-name"
+						namespace           = "my-cool-app"
+						path                = "/api/users"
+						project             = "my-gcp-project"
+						route_rule_priority = 1
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Only one of path or route_rule_priority can be set.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						region    = "us-central1"
+						regions   = ["us-central1", "us-east1"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Only one of region or regions can be set.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						regions   = ["all", "us-east1"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The all region can only be set alone, along with gateway.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						disambiguation = "oldest"
+						gateway        = "my-gateway-name"
+						namespace      = "my-cool-app"
+						project        = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The disambiguation must be one of`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-ingress-name"
+						kind      = "route"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The kind must be one of`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway_uid = "6f1c2b8e-0d3a-4b7e-9c55-2f8a1d4e7b90"
+						namespace   = "my-cool-app"
+						project     = "my-gcp-project"
+						service     = "my-service-name"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The gateway_uid can only be set along with gateway`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+
+						wait {
+							poll_interval = "-1s"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The poll_interval must be positive.`),
+			},
+			{
+				Config: `
+					data "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+
+						timeouts {
+							read = "soon"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The read timeout must be a duration such as`),
 			},
 			{
 				Config: `
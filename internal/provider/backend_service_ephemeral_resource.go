@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &BackendServiceEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &BackendServiceEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &BackendServiceEphemeralResource{}
+)
+
+func NewBackendServiceEphemeralResource() ephemeral.EphemeralResource {
+	return &BackendServiceEphemeralResource{}
+}
+
+// BackendServiceEphemeralResource defines the ephemeral resource implementation, which makes the same lookup as the
+// data source, with the same attributes, without the results being saved into the plan or state.
+type BackendServiceEphemeralResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+func (r *BackendServiceEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *BackendServiceEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_service"
+}
+
+func (r *BackendServiceEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Ephemeral resources have no provider_meta.
+	ctx = r.providerData.withUserAgent(ctx, tfsdk.Config{})
+
+	var data BackendServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataSource := &BackendServiceDataSource{providerData: r.providerData}
+
+	resp.Diagnostics.Append(dataSource.read(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *BackendServiceEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	var dataSourceResp datasource.SchemaResponse
+
+	(&BackendServiceDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &dataSourceResp)
+
+	blocks := map[string]schema.Block{}
+	for name, block := range dataSourceResp.Schema.Blocks {
+		blocks[name] = ephemeralBlock(block)
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes:          ephemeralAttributes(dataSourceResp.Schema.Attributes),
+		Blocks:              blocks,
+		MarkdownDescription: "Finds the backend service details for the load balancer created from a Kubernetes Gateway resource by GKE, like the `gkegateway_backend_service` data source, without saving them into the plan or state, so the topology of the load balancers doesn't end up in remote state for values only passed to other providers or ephemeral resources. Requires Terraform 1.10 or later.",
+	}
+}
+
+func (r *BackendServiceEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data BackendServiceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateBackendServiceLookup(ctx, &data, &resp.Diagnostics)
+}
+
+// ephemeralAttributes converts the attributes of a data source schema to the ones of an ephemeral resource schema, so
+// the ephemeral variants of the data sources don't duplicate their schemas.
+func ephemeralAttributes(attributes map[string]datasourceschema.Attribute) map[string]schema.Attribute {
+	converted := map[string]schema.Attribute{}
+
+	for name, attribute := range attributes {
+		converted[name] = ephemeralAttribute(attribute)
+	}
+
+	return converted
+}
+
+// ephemeralAttribute converts a data source attribute to an ephemeral resource one. Only the attribute types the data
+// sources use are supported.
+func ephemeralAttribute(attribute datasourceschema.Attribute) schema.Attribute {
+	switch a := attribute.(type) {
+	case datasourceschema.BoolAttribute:
+		return schema.BoolAttribute{Computed: a.Computed, MarkdownDescription: a.MarkdownDescription, Optional: a.Optional, Required: a.Required, Sensitive: a.Sensitive, Validators: a.Validators}
+	case datasourceschema.Float64Attribute:
+		return schema.Float64Attribute{Computed: a.Computed, MarkdownDescription: a.MarkdownDescription, Optional: a.Optional, Required: a.Required, Sensitive: a.Sensitive, Validators: a.Validators}
+	case datasourceschema.Int64Attribute:
+		return schema.Int64Attribute{Computed: a.Computed, MarkdownDescription: a.MarkdownDescription, Optional: a.Optional, Required: a.Required, Sensitive: a.Sensitive, Validators: a.Validators}
+	case datasourceschema.ListAttribute:
+		return schema.ListAttribute{Computed: a.Computed, ElementType: a.ElementType, MarkdownDescription: a.MarkdownDescription, Optional: a.Optional, Required: a.Required, Sensitive: a.Sensitive, Validators: a.Validators}
+	case datasourceschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			Computed:            a.Computed,
+			MarkdownDescription: a.MarkdownDescription,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ephemeralAttributes(a.NestedObject.Attributes),
+			},
+			Optional:   a.Optional,
+			Required:   a.Required,
+			Sensitive:  a.Sensitive,
+			Validators: a.Validators,
+		}
+	case datasourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			Attributes:          ephemeralAttributes(a.Attributes),
+			Computed:            a.Computed,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}
+	case datasourceschema.StringAttribute:
+		return schema.StringAttribute{Computed: a.Computed, MarkdownDescription: a.MarkdownDescription, Optional: a.Optional, Required: a.Required, Sensitive: a.Sensitive, Validators: a.Validators}
+	default:
+		panic(fmt.Sprintf("unsupported data source attribute type %T", attribute))
+	}
+}
+
+// ephemeralBlock converts a data source block to an ephemeral resource one. Only single nested blocks are supported.
+func ephemeralBlock(block datasourceschema.Block) schema.Block {
+	b, ok := block.(datasourceschema.SingleNestedBlock)
+	if !ok {
+		panic(fmt.Sprintf("unsupported data source block type %T", block))
+	}
+
+	blocks := map[string]schema.Block{}
+	for name, nested := range b.Blocks {
+		blocks[name] = ephemeralBlock(nested)
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes:          ephemeralAttributes(b.Attributes),
+		Blocks:              blocks,
+		MarkdownDescription: b.MarkdownDescription,
+		Validators:          b.Validators,
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBackendServiceEphemeralResourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// missing fields
+			{
+				Config: `
+					ephemeral "gkegateway_backend_service" "example" {
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						region    = "us-central1"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`At least one of gateway or service must be set.`),
+			},
+			// invalid fields
+			{
+				Config: `
+					ephemeral "gkegateway_backend_service" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						port      = 80800
+						project   = "my-gcp-project"
+						region    = "us-central1"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The port must be between 1 and 65535.`),
+			},
+			{
+				Config: `
+					ephemeral "gkegateway_backend_service" "example" {
+						gateway   = "My_Gateway"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The gateway must be an RFC 1123 label`),
+			},
+		},
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &BackendServicePatchListResource{}
+	_ list.ListResourceWithConfigure = &BackendServicePatchListResource{}
+)
+
+func NewBackendServicePatchListResource() list.ListResource {
+	return &BackendServicePatchListResource{}
+}
+
+// BackendServicePatchListResource defines the list resource implementation, which enumerates the gateways GKE has
+// created a load balancer for so their backend services can be imported as gkegateway_backend_service_patch resources.
+type BackendServicePatchListResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// BackendServicePatchListResourceModel describes the list resource data model.
+type BackendServicePatchListResourceModel struct {
+	Project types.String `tfsdk:"project"`
+	Region  types.String `tfsdk:"region"`
+}
+
+func (r *BackendServicePatchListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *BackendServicePatchListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.providerData == nil {
+		var diags diag.Diagnostics
+
+		diags.AddError("Unconfigured provider", "The provider must be configured before listing resources. Please report this issue to the provider developers.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	// List resources have no provider_meta.
+	ctx = r.providerData.withUserAgent(ctx, tfsdk.Config{})
+
+	var data BackendServicePatchListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	gatewayForwardingRules, diags := r.providerData.listGatewayForwardingRules(ctx, project, region)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		// A gateway with several listeners has several forwarding rules, but a single backend service patch.
+		seen := map[string]bool{}
+		count := int64(0)
+
+		for _, gfr := range gatewayForwardingRules {
+			name := gfr.namespace + "/" + gfr.gateway
+
+			if seen[name] {
+				continue
+			}
+
+			seen[name] = true
+
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, result.Identity, types.StringValue(project), region, types.StringValue(gfr.namespace), types.StringValue(gfr.gateway))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(r.listedResource(ctx, result, project, region, gfr)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (r *BackendServicePatchListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to search. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to search. If it is not provided, the provider region is used. When neither are provided, global load balancers are searched.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+		},
+		MarkdownDescription: "Lists the Kubernetes Gateway resources GKE has created a load balancer for in a project, either globally or in a region, as `gkegateway_backend_service_patch` resources to import, based on the description GKE writes on the forwarding rules. The listed resources patch no setting until one is configured.",
+	}
+}
+
+func (r *BackendServicePatchListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_service_patch"
+}
+
+// listedResource fills in the resource of a listed gateway as it would be imported, with the backend service of the
+// gateway found like Read does and the scope left null where it matches the provider defaults.
+func (r *BackendServicePatchListResource) listedResource(ctx context.Context, result list.ListResult, project string, region types.String, gfr gatewayForwardingRule) diag.Diagnostics {
+	backendService, diags := r.providerData.lookupBackendService(ctx, project, region, gfr.namespace, gfr.gateway)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	id := types.StringNull()
+	if backendService != nil {
+		id = types.StringValue(backendService.GetSelfLink())
+	}
+
+	stateProject, stateRegion := r.providerData.unresolveScope(types.StringValue(project), region)
+
+	diags.Append(result.Resource.SetAttribute(ctx, path.Root("gateway"), gfr.gateway)...)
+	diags.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id)...)
+	diags.Append(result.Resource.SetAttribute(ctx, path.Root("namespace"), gfr.namespace)...)
+	diags.Append(result.Resource.SetAttribute(ctx, path.Root("project"), stateProject)...)
+	diags.Append(result.Resource.SetAttribute(ctx, path.Root("region"), stateRegion)...)
+
+	return diags
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAccBackendServicePatchListResource(t *testing.T) {
+	resources := testAccGatewayComputeResources()
+
+	// A gateway with an HTTP listener too has a second forwarding rule, but is listed once.
+	resources["projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-efgh"] = &computepb.ForwardingRule{
+		Description: proto.String(`{"k8sResource":"/namespaces/my-cool-app/gateways/my-gateway-name"}`),
+		IPAddress:   proto.String("203.0.113.10"),
+		Name:        proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-efgh"),
+		PortRange:   proto.String("80-80"),
+		SelfLink:    proto.String("https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-efgh"),
+		Target:      proto.String("https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/targetHttpsProxies/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+	}
+
+	server := testAccComputeServer(t, resources)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// The query needs a working directory initialized with the provider.
+			{
+				Config: testAccComputeProviderConfig(server),
+			},
+			{
+				Query: true,
+				Config: testAccComputeProviderConfig(server) + `
+					list "gkegateway_backend_service_patch" "example" {
+						provider = gkegateway
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("gkegateway_backend_service_patch.example", 1),
+					querycheck.ExpectIdentity("gkegateway_backend_service_patch.example", map[string]knownvalue.Check{
+						"gateway":   knownvalue.StringExact("my-gateway-name"),
+						"namespace": knownvalue.StringExact("my-cool-app"),
+						"project":   knownvalue.StringExact("my-gcp-project"),
+						"region":    knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccBackendServicePatchListResourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// The query needs a working directory initialized with the provider.
+			{
+				Config: `
+					provider "gkegateway" {}
+				`,
+			},
+			// invalid fields
+			{
+				Query: true,
+				Config: `
+					provider "gkegateway" {}
+
+					list "gkegateway_backend_service_patch" "example" {
+						provider = gkegateway
+
+						config {
+							project = "My_Project"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The project must be a GCP project ID`),
+			},
+		},
+	})
+}
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BackendServicePatchResource{}
+	_ resource.ResourceWithIdentity    = &BackendServicePatchResource{}
+	_ resource.ResourceWithImportState = &BackendServicePatchResource{}
+	_ resource.ResourceWithModifyPlan  = &BackendServicePatchResource{}
+)
+
+func NewBackendServicePatchResource() resource.Resource {
+	return &BackendServicePatchResource{}
+}
+
+// BackendServicePatchResource defines the resource implementation.
+type BackendServicePatchResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// BackendServicePatchResourceModel describes the resource data model.
+type BackendServicePatchResourceModel struct {
+	Gateway           types.String `tfsdk:"gateway"`
+	ID                types.String `tfsdk:"id"`
+	MaxStreamDuration types.String `tfsdk:"max_stream_duration"`
+	Namespace         types.String `tfsdk:"namespace"`
+	PendingChanges    types.List   `tfsdk:"pending_changes"`
+	Project           types.String `tfsdk:"project"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Region            types.String `tfsdk:"region"`
+}
+
+func (r *BackendServicePatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *BackendServicePatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data BackendServicePatchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.patch(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+}
+
+func (r *BackendServicePatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The patched fields are left as they are, the controller owns the backend service.
+}
+
+func (r *BackendServicePatchResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: gatewayIdentityAttributes(),
+	}
+}
+
+func (r *BackendServicePatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importGatewayIdentity(ctx, req, resp)
+}
+
+func (r *BackendServicePatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_service_patch"
+}
+
+func (r *BackendServicePatchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is patched on destroy, and nothing can be looked up before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data, state BackendServicePatchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The changes stay known after apply until every input is.
+	if data.Gateway.IsUnknown() || data.Namespace.IsUnknown() || data.Project.IsUnknown() || data.Region.IsUnknown() || data.MaxStreamDuration.IsUnknown() || data.Protocol.IsUnknown() {
+		return
+	}
+
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendService, diags := r.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || backendService == nil {
+		return
+	}
+
+	pendingChanges, diags := planPendingChanges(ctx, backendServicePatchChanges(backendService, &data), state.PendingChanges)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_changes"), pendingChanges)...)
+}
+
+func (r *BackendServicePatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data BackendServicePatchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendService, diags := r.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The gateway is gone, so is the patch.
+	if backendService == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only report drift on the fields this resource manages.
+	data.ID = types.StringValue(backendService.GetSelfLink())
+
+	if !data.MaxStreamDuration.IsNull() && !equalComputeDuration(data.MaxStreamDuration.ValueString(), backendService.GetMaxStreamDuration()) {
+		data.MaxStreamDuration = formatComputeDuration(backendService.GetMaxStreamDuration())
+	}
+
+	if !data.Protocol.IsNull() {
+		data.Protocol = types.StringValue(backendService.GetProtocol())
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+}
+
+func (r *BackendServicePatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URI of the patched backend service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_stream_duration": schema.StringAttribute{
+				MarkdownDescription: "The maximum duration of a stream, such as a long-lived gRPC call, before it is closed, as a duration such as `3600s`. When not provided, the setting is left untouched.",
+				Optional:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"pending_changes": pendingChangesAttribute(),
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{projectIDValidator()},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol the load balancer uses to talk to the backends, one of `HTTP`, `HTTPS`, `HTTP2` or `H2C`, for when GKE infers the wrong one from the `appProtocol` of the Service port. When not provided, the setting is left untouched.",
+				Optional:            true,
+				Validators:          []validator.String{oneOfValidator{values: []string{"HTTP", "HTTPS", "HTTP2", "H2C"}}},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{regionValidator()},
+			},
+		},
+		MarkdownDescription: "Patches settings that no Gateway API or GKE policy resource exposes onto the backend service of the load balancer created from a Kubernetes Gateway resource by GKE. Only the configured settings are changed and destroying the resource leaves them in place. The same single backend service limitations as the `gkegateway_backend_service` data source apply.",
+	}
+}
+
+func (r *BackendServicePatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data BackendServicePatchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.patch(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+}
+
+// patch applies the configured settings to the backend service of the gateway and waits for the change to complete.
+func (r *BackendServicePatchResource) patch(ctx context.Context, data *BackendServicePatchResourceModel) diag.Diagnostics {
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Check the settings before looking anything up.
+	patch := &computepb.BackendService{Protocol: data.Protocol.ValueStringPointer()}
+
+	if !data.MaxStreamDuration.IsNull() {
+		maxStreamDuration, err := parseComputeDuration(data.MaxStreamDuration.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("max_stream_duration"), "Invalid max_stream_duration", fmt.Sprintf("The max_stream_duration must be a duration such as `3600s`: %s", err))
+			return diags
+		}
+
+		patch.MaxStreamDuration = maxStreamDuration
+	}
+
+	backendService, lookupDiags := r.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	diags.Append(lookupDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if backendService == nil {
+		diags.AddError("No backend service found", fmt.Sprintf("No load balancer was found for gateway %s/%s, it may not have been programmed by GKE yet.", data.Namespace.ValueString(), data.Gateway.ValueString()))
+		return diags
+	}
+
+	// Report what is about to change when the plan couldn't.
+	if data.PendingChanges.IsUnknown() {
+		var changesDiags diag.Diagnostics
+
+		data.PendingChanges, changesDiags = pendingChangesValue(ctx, backendServicePatchChanges(backendService, data))
+		diags.Append(changesDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// The fingerprint makes the patch fail rather than overwrite a concurrent change by the controller.
+	patch.Fingerprint = backendService.Fingerprint
+
+	var (
+		err       error
+		operation *compute.Operation
+	)
+
+	if region.IsNull() {
+		operation, err = r.providerData.backendServicesClient.Patch(ctx, &computepb.PatchBackendServiceRequest{
+			BackendService:         backendService.GetName(),
+			BackendServiceResource: patch,
+			Project:                selfLinkProject(backendService.GetSelfLink(), project),
+		})
+	} else {
+		operation, err = r.providerData.regionBackendServicesClient.Patch(ctx, &computepb.PatchRegionBackendServiceRequest{
+			BackendService:         backendService.GetName(),
+			BackendServiceResource: patch,
+			Project:                selfLinkProject(backendService.GetSelfLink(), project),
+			Region:                 region.ValueString(),
+		})
+	}
+
+	if err == nil {
+		err = operation.Wait(ctx)
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error patching backend service %s", backendService.GetName()), fmt.Sprintf("Error calling Google API: %+v", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(backendService.GetSelfLink())
+
+	return diags
+}
+
+// backendServicePatchChanges lists the configured settings that differ from the live backend service.
+func backendServicePatchChanges(backendService *computepb.BackendService, data *BackendServicePatchResourceModel) []pendingChange {
+	changes := []pendingChange{}
+
+	if !data.MaxStreamDuration.IsNull() && !equalComputeDuration(data.MaxStreamDuration.ValueString(), backendService.GetMaxStreamDuration()) {
+		changes = append(changes, pendingChange{
+			Current: formatComputeDuration(backendService.GetMaxStreamDuration()),
+			Field:   types.StringValue("maxStreamDuration"),
+			Planned: data.MaxStreamDuration,
+		})
+	}
+
+	if !data.Protocol.IsNull() && data.Protocol.ValueString() != backendService.GetProtocol() {
+		changes = append(changes, pendingChange{
+			Current: types.StringValue(backendService.GetProtocol()),
+			Field:   types.StringValue("protocol"),
+			Planned: data.Protocol,
+		})
+	}
+
+	return changes
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackendServicePatchResourceImport(t *testing.T) {
+	server := testAccComputeServer(t, testAccGatewayComputeResources())
+
+	config := testAccComputeProviderConfig(server) + `
+		resource "gkegateway_backend_service_patch" "example" {
+			gateway   = "my-gateway-name"
+			namespace = "my-cool-app"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// The project matching the provider default is left null, so the configuration doesn't plan a replace.
+			{
+				Config:                  config,
+				ResourceName:            "gkegateway_backend_service_patch.example",
+				ImportState:             true,
+				ImportStateId:           "my-gcp-project/my-cool-app/my-gateway-name",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pending_changes"},
+			},
+		},
+	})
+}
+
+func TestAccBackendServicePatchResourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// missing fields
+			{
+				Config: `
+					resource "gkegateway_backend_service_patch" "example" {
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "gateway" is required, but no definition was found.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_backend_service_patch" "example" {
+						gateway = "my-gateway-name"
+						project = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "namespace" is required, but no definition was found.`),
+			},
+			// invalid settings
+			{
+				Config: `
+					resource "gkegateway_backend_service_patch" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						protocol  = "GRPC"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The protocol must be one of`),
+			},
+		},
+	})
+}
+
+func TestBackendServicePatchResourceProtocolValidator(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	NewBackendServicePatchResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	validators := schemaResp.Schema.Attributes["protocol"].(schema.StringAttribute).Validators
+
+	tests := map[string]struct {
+		protocol types.String
+		error    string
+	}{
+		"unknown": {protocol: types.StringUnknown()},
+		"HTTP2":   {protocol: types.StringValue("HTTP2")},
+		"GRPC":    {protocol: types.StringValue("GRPC"), error: "The protocol must be one of `HTTP`, `HTTPS`, `HTTP2` or `H2C`, got `GRPC`."},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			for _, v := range validators {
+				v.ValidateString(ctx, validator.StringRequest{ConfigValue: test.protocol, Path: path.Root("protocol")}, resp)
+			}
+
+			if test.error == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Detail() != test.error {
+				t.Errorf("expected error %q, got %v", test.error, resp.Diagnostics)
+			}
+		})
+	}
+}
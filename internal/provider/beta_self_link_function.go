@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// computeSelfLinkPattern matches a compute self_link, from either API host and for any API version, or a relative
+// resource ID, capturing the path from the project onwards.
+var computeSelfLinkPattern = regexp.MustCompile(`^(?:https://(?:www|compute)\.googleapis\.com/compute/[a-z0-9]+/)?(projects/[^/]+/(?:global|regions/[^/]+|zones/[^/]+)/[A-Za-z]+/[^/]+)$`)
+
+// sameComputeResource reports whether two self_links or IDs reference the same compute resource, whichever their API
+// version.
+func sameComputeResource(a string, b string) bool {
+	aMatches := computeSelfLinkPattern.FindStringSubmatch(a)
+	bMatches := computeSelfLinkPattern.FindStringSubmatch(b)
+
+	return aMatches != nil && bMatches != nil && aMatches[1] == bMatches[1]
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &BetaSelfLinkFunction{}
+
+func NewBetaSelfLinkFunction() function.Function {
+	return &BetaSelfLinkFunction{}
+}
+
+// BetaSelfLinkFunction defines the function implementation.
+type BetaSelfLinkFunction struct{}
+
+func (f *BetaSelfLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Converts the self_link or ID of a compute resource, such as the ones returned by the data sources of this provider, into the beta API self_link that `google-beta` resources store and compare against. The GA and beta URLs of a resource differ only by their API version, so the same resource would otherwise show up as a diff.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The self_link, for any API version, or relative ID, such as `projects/{{project}}/global/backendServices/{{name}}`, of the resource.",
+				Name:                "self_link",
+			},
+		},
+		Return:  function.StringReturn{},
+		Summary: "Convert a compute self_link into its beta API form",
+	}
+}
+
+func (f *BetaSelfLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "beta_self_link"
+}
+
+func (f *BetaSelfLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var selfLink string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &selfLink))
+
+	if resp.Error != nil {
+		return
+	}
+
+	matches := computeSelfLinkPattern.FindStringSubmatch(selfLink)
+	if matches == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not the self_link or ID of a compute resource.", selfLink))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fmt.Sprintf("https://www.googleapis.com/compute/beta/%s", matches[1])))
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBetaSelfLinkFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::beta_self_link("https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/backendServices/my-backend-service")
+					}
+				`,
+				Check: resource.TestCheckOutput("test", "https://www.googleapis.com/compute/beta/projects/my-gcp-project/global/backendServices/my-backend-service"),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::beta_self_link("projects/my-gcp-project/regions/us-central1/backendServices/my-backend-service")
+					}
+				`,
+				Check: resource.TestCheckOutput("test", "https://www.googleapis.com/compute/beta/projects/my-gcp-project/regions/us-central1/backendServices/my-backend-service"),
+			},
+			// invalid self_link
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::beta_self_link("my-backend-service")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`is not the self_link or ID of a compute resource`),
+			},
+		},
+	})
+}
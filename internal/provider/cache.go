@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// GKEGatewayProviderModelCache describes the cache block of the provider.
+type GKEGatewayProviderModelCache struct {
+	Path types.String `tfsdk:"path"`
+	TTL  types.String `tfsdk:"ttl"`
+}
+
+// diskCache keeps API responses in a local directory between runs, so plans of scopes with tens of thousands of compute
+// resources don't list them in full every time. The cache is best effort, failing to read or write it never fails the
+// lookup, and a nil diskCache, when the setting is off, caches nothing.
+type diskCache struct {
+	path string
+	ttl  time.Duration
+}
+
+// get reads the cached response of the key into the message, reporting whether one younger than the TTL was found.
+func (c *diskCache) get(key string, message proto.Message) bool {
+	if c == nil {
+		return false
+	}
+
+	file := c.file(key)
+
+	info, err := os.Stat(file)
+	if err != nil || time.Since(info.ModTime()) > c.ttl {
+		return false
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return false
+	}
+
+	return protojson.Unmarshal(content, message) == nil
+}
+
+// put caches the response of the key. The file is renamed into place, so concurrent runs never read a partial response.
+func (c *diskCache) put(key string, message proto.Message) {
+	if c == nil {
+		return
+	}
+
+	content, err := protojson.Marshal(message)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(c.path, 0o700); err != nil {
+		return
+	}
+
+	temp, err := os.CreateTemp(c.path, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(temp.Name())
+
+	_, err = temp.Write(content)
+	if closeErr := temp.Close(); err != nil || closeErr != nil {
+		return
+	}
+
+	_ = os.Rename(temp.Name(), c.file(key))
+}
+
+// file returns the path caching the key, hashed so no project or resource names show up in the directory listing.
+func (c *diskCache) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.path, hex.EncodeToString(sum[:])+".json")
+}
+
+// parseCacheTTL parses how long the cached responses are used, falling back to the default when it isn't set.
+func parseCacheTTL(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return 15 * time.Minute, diags
+	}
+
+	ttl, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("cache").AtName("ttl"), "Invalid ttl", fmt.Sprintf("The ttl must be a duration such as `15m`: %s", err))
+		return 0, diags
+	}
+
+	if ttl <= 0 {
+		diags.AddAttributeError(path.Root("cache").AtName("ttl"), "Invalid ttl", "The ttl must be positive.")
+		return 0, diags
+	}
+
+	return ttl, diags
+}
+
+// forwardingRulesCache keeps the gateway forwarding rules of each project and region for the lifetime of the provider
+// process, which Terraform starts for each plan or apply, so data sources reading the same scope list it only once.
+type forwardingRulesCache struct {
+	entries map[string]*forwardingRulesCacheEntry
+	mu      sync.Mutex
+}
+
+// forwardingRulesCacheEntry is the listing of a scope, done is closed once it is complete.
+type forwardingRulesCacheEntry struct {
+	diags diag.Diagnostics
+	done  chan struct{}
+	rules []gatewayForwardingRule
+}
+
+// forwardingRulesCacheKey identifies the scope of a listing, an empty region standing for the global load balancers.
+func forwardingRulesCacheKey(project string, region types.String) string {
+	return fmt.Sprintf("%s/%s", project, region.ValueString())
+}
+
+// load returns the cached listing of the scope, scanning it when it isn't cached yet or a refresh is asked for. Reads
+// of a scope being scanned wait for that scan rather than starting another. Failed scans aren't kept, so the next read
+// tries again. The returned boolean reports whether the listing was scanned by this call.
+func (c *forwardingRulesCache) load(key string, refresh bool, scan func() ([]gatewayForwardingRule, diag.Diagnostics)) ([]gatewayForwardingRule, diag.Diagnostics, bool) {
+	c.mu.Lock()
+
+	if entry, ok := c.entries[key]; ok && !refresh {
+		c.mu.Unlock()
+		<-entry.done
+
+		return entry.rules, entry.diags, false
+	}
+
+	entry := &forwardingRulesCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	entry.rules, entry.diags = scan()
+	close(entry.done)
+
+	if entry.diags.HasError() {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+
+	return entry.rules, entry.diags, true
+}
@@ -0,0 +1,527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &CanaryWeightsResource{}
+	_ resource.ResourceWithIdentity       = &CanaryWeightsResource{}
+	_ resource.ResourceWithImportState    = &CanaryWeightsResource{}
+	_ resource.ResourceWithModifyPlan     = &CanaryWeightsResource{}
+	_ resource.ResourceWithValidateConfig = &CanaryWeightsResource{}
+)
+
+func NewCanaryWeightsResource() resource.Resource {
+	return &CanaryWeightsResource{}
+}
+
+// CanaryWeightsResource defines the resource implementation.
+type CanaryWeightsResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// CanaryWeightsResourceModel describes the resource data model.
+type CanaryWeightsResourceModel struct {
+	Gateway        types.String `tfsdk:"gateway"`
+	ID             types.String `tfsdk:"id"`
+	Namespace      types.String `tfsdk:"namespace"`
+	PathMatcher    types.String `tfsdk:"path_matcher"`
+	PendingChanges types.List   `tfsdk:"pending_changes"`
+	Priority       types.Int64  `tfsdk:"priority"`
+	Project        types.String `tfsdk:"project"`
+	Region         types.String `tfsdk:"region"`
+	Weights        types.Map    `tfsdk:"weights"`
+}
+
+func (r *CanaryWeightsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *CanaryWeightsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data CanaryWeightsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+}
+
+func (r *CanaryWeightsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The weights are left as they are, the controller owns the URL map and resets them on its next sync.
+}
+
+func (r *CanaryWeightsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: gatewayIdentityAttributes(),
+	}
+}
+
+func (r *CanaryWeightsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importGatewayIdentity(ctx, req, resp)
+}
+
+func (r *CanaryWeightsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_canary_weights"
+}
+
+func (r *CanaryWeightsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is patched on destroy, and nothing can be looked up before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data, state CanaryWeightsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The changes stay known after apply until every input is.
+	if data.Gateway.IsUnknown() || data.Namespace.IsUnknown() || data.PathMatcher.IsUnknown() || data.Priority.IsUnknown() || data.Project.IsUnknown() || data.Region.IsUnknown() || data.Weights.IsUnknown() {
+		return
+	}
+
+	weights, diags := canaryWeights(ctx, data.Weights)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || weights == nil {
+		return
+	}
+
+	urlMap, action, diags := r.findSplit(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || urlMap == nil {
+		return
+	}
+
+	pendingChanges, diags := planPendingChanges(ctx, canaryWeightsChanges(action, weights), state.PendingChanges)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_changes"), pendingChanges)...)
+}
+
+func (r *CanaryWeightsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data CanaryWeightsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urlMap, action, diags := r.findSplit(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The gateway is gone, so is the split.
+	if urlMap == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	weights := map[string]int64{}
+	for _, wbs := range action.GetWeightedBackendServices() {
+		weights[weightedBackendServiceName(wbs)] = int64(wbs.GetWeight())
+	}
+
+	data.ID = types.StringValue(urlMap.GetSelfLink())
+
+	data.Weights, diags = types.MapValueFrom(ctx, types.Int64Type, weights)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+}
+
+func (r *CanaryWeightsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URI of the patched URL map.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"path_matcher": schema.StringAttribute{
+				MarkdownDescription: "Name of the URL map path matcher holding the split. Only needed when the URL map has several weighted splits.",
+				Optional:            true,
+			},
+			"pending_changes": pendingChangesAttribute(),
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the route rule holding the split. Only needed when the URL map has several weighted splits.",
+				Optional:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{regionValidator()},
+			},
+			"weights": schema.MapAttribute{
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Weight of each backend service of the split, keyed by backend service name. Every backend service already in the split must be listed and the weights must sum to 100.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Sets the weights of an existing weighted backend split in the URL map of the load balancer created from a Kubernetes Gateway resource by GKE, so traffic can be shifted by a promotion pipeline. The split itself, generated from the weighted `backendRefs` of a route, is left to the controller, which restores the weights from the route on its next sync. Destroying the resource leaves the weights in place.",
+	}
+}
+
+func (r *CanaryWeightsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data CanaryWeightsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+}
+
+func (r *CanaryWeightsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CanaryWeightsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Weights.IsUnknown() {
+		return
+	}
+
+	_, diags := canaryWeights(ctx, data.Weights)
+	resp.Diagnostics.Append(diags...)
+}
+
+// apply sets the configured weights on the split and waits for the change to complete.
+func (r *CanaryWeightsResource) apply(ctx context.Context, data *CanaryWeightsResourceModel) diag.Diagnostics {
+	weights, diags := canaryWeights(ctx, data.Weights)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	urlMap, action, findDiags := r.findSplit(ctx, data)
+	diags.Append(findDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if urlMap == nil {
+		diags.AddError("No URL map found", fmt.Sprintf("No load balancer was found for gateway %s/%s, it may not have been programmed by GKE yet.", data.Namespace.ValueString(), data.Gateway.ValueString()))
+		return diags
+	}
+
+	// Only the weights can change, the backend services of the split belong to the controller.
+	existing := []string{}
+	for _, wbs := range action.GetWeightedBackendServices() {
+		existing = append(existing, weightedBackendServiceName(wbs))
+	}
+
+	configured := []string{}
+	for name := range weights {
+		configured = append(configured, name)
+	}
+
+	sort.Strings(existing)
+	sort.Strings(configured)
+
+	if strings.Join(existing, ",") != strings.Join(configured, ",") {
+		diags.AddAttributeError(path.Root("weights"), "Backend services don't match the split", fmt.Sprintf("The weights must be set for exactly the backend services of the split: %s.", strings.Join(existing, ", ")))
+		return diags
+	}
+
+	// Report what is about to change when the plan couldn't.
+	if data.PendingChanges.IsUnknown() {
+		var changesDiags diag.Diagnostics
+
+		data.PendingChanges, changesDiags = pendingChangesValue(ctx, canaryWeightsChanges(action, weights))
+		diags.Append(changesDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, wbs := range action.GetWeightedBackendServices() {
+		weight := uint32(weights[weightedBackendServiceName(wbs)])
+		wbs.Weight = &weight
+	}
+
+	project, region, scopeDiags := r.providerData.resolveScope(data.Project, data.Region)
+	diags.Append(scopeDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// The whole map is sent back with its fingerprint, failing rather than overwriting a concurrent controller sync.
+	var (
+		err       error
+		operation *compute.Operation
+	)
+
+	if region.IsNull() {
+		operation, err = r.providerData.urlMapsClient.Update(ctx, &computepb.UpdateUrlMapRequest{
+			Project:        selfLinkProject(urlMap.GetSelfLink(), project),
+			UrlMap:         urlMap.GetName(),
+			UrlMapResource: urlMap,
+		})
+	} else {
+		operation, err = r.providerData.regionUrlMapsClient.Update(ctx, &computepb.UpdateRegionUrlMapRequest{
+			Project:        selfLinkProject(urlMap.GetSelfLink(), project),
+			Region:         region.ValueString(),
+			UrlMap:         urlMap.GetName(),
+			UrlMapResource: urlMap,
+		})
+	}
+
+	if err == nil {
+		err = operation.Wait(ctx)
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error updating URL map %s", urlMap.GetName()), fmt.Sprintf("Error calling Google API: %+v", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(urlMap.GetSelfLink())
+
+	return diags
+}
+
+// findSplit returns the URL map of the gateway and the route action holding the selected weighted split. Both are nil
+// when no forwarding rule exists for the gateway.
+func (r *CanaryWeightsResource) findSplit(ctx context.Context, data *CanaryWeightsResourceModel) (*computepb.UrlMap, *computepb.HttpRouteAction, diag.Diagnostics) {
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	forwardingRule, ruleDiags := r.providerData.findGatewayForwardingRule(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	diags.Append(ruleDiags...)
+
+	if diags.HasError() || forwardingRule == nil {
+		return nil, nil, diags
+	}
+
+	urlMap, urlMapDiags := r.providerData.getUrlMap(ctx, project, region, forwardingRule)
+	diags.Append(urlMapDiags...)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	candidates := []*computepb.HttpRouteAction{}
+	descriptions := []string{}
+
+	for _, matcher := range urlMap.GetPathMatchers() {
+		if !data.PathMatcher.IsNull() && matcher.GetName() != data.PathMatcher.ValueString() {
+			continue
+		}
+
+		if data.Priority.IsNull() && len(matcher.GetDefaultRouteAction().GetWeightedBackendServices()) > 0 {
+			candidates = append(candidates, matcher.GetDefaultRouteAction())
+			descriptions = append(descriptions, fmt.Sprintf("%s (default route)", matcher.GetName()))
+		}
+
+		for _, rule := range matcher.GetRouteRules() {
+			if !data.Priority.IsNull() && int64(rule.GetPriority()) != data.Priority.ValueInt64() {
+				continue
+			}
+
+			if len(rule.GetRouteAction().GetWeightedBackendServices()) > 0 {
+				candidates = append(candidates, rule.GetRouteAction())
+				descriptions = append(descriptions, fmt.Sprintf("%s (priority %d)", matcher.GetName(), rule.GetPriority()))
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		diags.AddError("No weighted split found", fmt.Sprintf("The %s URL map has no matching route with weighted backend services.", urlMap.GetName()))
+		return nil, nil, diags
+	} else if len(candidates) > 1 {
+		debugMessage := "The following splits matched, use path_matcher and priority to select one:\n\n"
+		for _, description := range descriptions {
+			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, description)
+		}
+
+		diags.AddError("Multiple weighted splits found", debugMessage)
+		return nil, nil, diags
+	}
+
+	return urlMap, candidates[0], diags
+}
+
+// canaryWeightsChanges lists the backend services of the split whose weight differs from the configured one.
+func canaryWeightsChanges(action *computepb.HttpRouteAction, weights map[string]int64) []pendingChange {
+	changes := []pendingChange{}
+
+	for _, wbs := range action.GetWeightedBackendServices() {
+		name := weightedBackendServiceName(wbs)
+
+		weight, ok := weights[name]
+		if !ok || weight == int64(wbs.GetWeight()) {
+			continue
+		}
+
+		changes = append(changes, pendingChange{
+			Current: types.StringValue(fmt.Sprintf("%d", wbs.GetWeight())),
+			Field:   types.StringValue(fmt.Sprintf("weightedBackendServices[%s].weight", name)),
+			Planned: types.StringValue(fmt.Sprintf("%d", weight)),
+		})
+	}
+
+	return changes
+}
+
+// canaryWeights converts the configured weights, checking that they add up to 100.
+func canaryWeights(ctx context.Context, value types.Map) (map[string]int64, diag.Diagnostics) {
+	weights := map[string]types.Int64{}
+
+	diags := value.ElementsAs(ctx, &weights, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := map[string]int64{}
+	sum := int64(0)
+
+	for name, weight := range weights {
+		// Wait for every weight to be known before checking the sum.
+		if weight.IsUnknown() {
+			return nil, diags
+		}
+
+		if weight.IsNull() || weight.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root("weights").AtMapKey(name), "Invalid weight", "Weights must be non-negative numbers.")
+			return nil, diags
+		}
+
+		result[name] = weight.ValueInt64()
+		sum += weight.ValueInt64()
+	}
+
+	if sum != 100 {
+		diags.AddAttributeError(path.Root("weights"), "Invalid weights", fmt.Sprintf("The weights must sum to 100, got %d.", sum))
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// weightedBackendServiceName returns the name of the backend service of a split, which is referenced by its self_link.
+func weightedBackendServiceName(wbs *computepb.WeightedBackendService) string {
+	backendServiceComponents := strings.Split(wbs.GetBackendService(), "/")
+
+	return backendServiceComponents[len(backendServiceComponents)-1]
+}
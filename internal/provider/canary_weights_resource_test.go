@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCanaryWeightsResourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// missing fields
+			{
+				Config: `
+					resource "gkegateway_canary_weights" "example" {
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						weights   = { stable = 100 }
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "gateway" is required, but no definition was found.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_canary_weights" "example" {
+						gateway = "my-gateway-name"
+						project = "my-gcp-project"
+						weights = { stable = 100 }
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "namespace" is required, but no definition was found.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_canary_weights" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "weights" is required, but no definition was found.`),
+			},
+			// invalid weights
+			{
+				Config: `
+					resource "gkegateway_canary_weights" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						weights   = { stable = 90, canary = 20 }
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The weights must sum to 100, got 110.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_canary_weights" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						weights   = { stable = 0, canary = 0 }
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The weights must sum to 100, got 0.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_canary_weights" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						weights   = { stable = 110, canary = -10 }
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Weights must be non-negative numbers.`),
+			},
+		},
+	})
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CertificateMapDataSource{}
+
+func NewCertificateMapDataSource() datasource.DataSource {
+	return &CertificateMapDataSource{}
+}
+
+// CertificateMapDataSource defines the data source implementation.
+type CertificateMapDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// CertificateMapDataSourceModel describes the data source data model.
+type CertificateMapDataSourceModel struct {
+	CertificateMap *CertificateMapDataSourceModelCertificateMap `tfsdk:"certificate_map"`
+	Gateway        types.String                                 `tfsdk:"gateway"`
+	Namespace      types.String                                 `tfsdk:"namespace"`
+	Project        types.String                                 `tfsdk:"project"`
+	Region         types.String                                 `tfsdk:"region"`
+}
+
+type CertificateMapDataSourceModelCertificate struct {
+	ExpireTime   types.String `tfsdk:"expire_time"`
+	ManagedState types.String `tfsdk:"managed_state"`
+	Name         types.String `tfsdk:"name"`
+}
+
+type CertificateMapDataSourceModelCertificateMap struct {
+	Entries []CertificateMapDataSourceModelEntry `tfsdk:"entries"`
+	ID      types.String                         `tfsdk:"id"`
+	Name    types.String                         `tfsdk:"name"`
+}
+
+type CertificateMapDataSourceModelEntry struct {
+	Certificates []CertificateMapDataSourceModelCertificate `tfsdk:"certificates"`
+	Hostname     types.String                               `tfsdk:"hostname"`
+	Matcher      types.String                               `tfsdk:"matcher"`
+	Name         types.String                               `tfsdk:"name"`
+	State        types.String                               `tfsdk:"state"`
+}
+
+func (d *CertificateMapDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *CertificateMapDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_map"
+}
+
+func (d *CertificateMapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data CertificateMapDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRule, diags := d.providerData.findGatewayForwardingRule(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || forwardingRule == nil {
+		return
+	}
+
+	// Only HTTPS and SSL proxies carry a certificate map.
+	targetComponents := strings.Split(forwardingRule.GetTarget(), "/")
+
+	var proxyCertificateMap string
+
+	switch targetComponents[len(targetComponents)-2] {
+	case "targetHttpsProxies":
+		proxy, diags := d.providerData.getTargetHttpsProxy(ctx, selfLinkProject(forwardingRule.GetTarget(), project), region, targetComponents[len(targetComponents)-1])
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		proxyCertificateMap = proxy.GetCertificateMap()
+	case "targetSslProxies":
+		proxy, diags := d.providerData.getTargetSslProxy(ctx, selfLinkProject(forwardingRule.GetTarget(), project), region, targetComponents[len(targetComponents)-1])
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		proxyCertificateMap = proxy.GetCertificateMap()
+	default:
+		resp.Diagnostics.AddError("Unsupported target type for forwarding rule", fmt.Sprintf("The %s forwarding rule has a target with a type of %s which does not support certificate maps.", forwardingRule.GetName(), targetComponents[len(targetComponents)-2]))
+		return
+	}
+
+	// Without the certmap annotation the proxy uses SSL certificates instead.
+	if proxyCertificateMap == "" {
+		return
+	}
+
+	// The proxy references the map with format //certificatemanager.googleapis.com/projects/{{project}}/locations/global/certificateMaps/{{name}}.
+	certificateMapID := strings.TrimPrefix(proxyCertificateMap, "//certificatemanager.googleapis.com/")
+
+	certificateMap, err := d.providerData.certificateManagerService.Projects.Locations.CertificateMaps.Get(certificateMapID).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error looking up certificate map %s", certificateMapID), fmt.Sprintf("Error calling Google API: %+v", err))
+		return
+	}
+
+	certificateMapComponents := strings.Split(certificateMap.Name, "/")
+
+	data.CertificateMap = &CertificateMapDataSourceModelCertificateMap{
+		Entries: []CertificateMapDataSourceModelEntry{},
+		ID:      types.StringValue(certificateMap.Name),
+		Name:    types.StringValue(certificateMapComponents[len(certificateMapComponents)-1]),
+	}
+
+	// Entries often share certificates, so each one is only looked up once.
+	certificates := map[string]*certificatemanager.Certificate{}
+
+	err = d.providerData.certificateManagerService.Projects.Locations.CertificateMaps.CertificateMapEntries.List(certificateMap.Name).Pages(ctx, func(page *certificatemanager.ListCertificateMapEntriesResponse) error {
+		for _, entry := range page.CertificateMapEntries {
+			entryComponents := strings.Split(entry.Name, "/")
+
+			entryModel := CertificateMapDataSourceModelEntry{
+				Certificates: []CertificateMapDataSourceModelCertificate{},
+				Hostname:     types.StringNull(),
+				Matcher:      types.StringNull(),
+				Name:         types.StringValue(entryComponents[len(entryComponents)-1]),
+				State:        types.StringValue(entry.State),
+			}
+
+			if entry.Hostname != "" {
+				entryModel.Hostname = types.StringValue(entry.Hostname)
+			}
+
+			if entry.Matcher != "" {
+				entryModel.Matcher = types.StringValue(entry.Matcher)
+			}
+
+			for _, certificateID := range entry.Certificates {
+				certificate, ok := certificates[certificateID]
+
+				if !ok {
+					certificate, err = d.providerData.certificateManagerService.Projects.Locations.Certificates.Get(certificateID).Context(ctx).Do()
+					if err != nil {
+						return fmt.Errorf("looking up certificate %s: %w", certificateID, err)
+					}
+
+					certificates[certificateID] = certificate
+				}
+
+				certificateComponents := strings.Split(certificate.Name, "/")
+
+				// Self-managed certificates have no provisioning state.
+				managedState := types.StringNull()
+				if certificate.Managed != nil {
+					managedState = types.StringValue(certificate.Managed.State)
+				}
+
+				entryModel.Certificates = append(entryModel.Certificates, CertificateMapDataSourceModelCertificate{
+					ExpireTime:   types.StringValue(certificate.ExpireTime),
+					ManagedState: managedState,
+					Name:         types.StringValue(certificateComponents[len(certificateComponents)-1]),
+				})
+			}
+
+			data.CertificateMap.Entries = append(data.CertificateMap.Entries, entryModel)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error looking up the entries of certificate map %s", certificateMap.Name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *CertificateMapDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"certificate_map": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"entries": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"certificates": schema.ListNestedAttribute{
+									Computed: true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"expire_time": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "When the certificate expires, as an RFC 3339 timestamp - will be empty until a managed certificate is issued.",
+											},
+											"managed_state": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "The provisioning state of the Google-managed certificate, such as `PROVISIONING` or `ACTIVE` - will be null for self-managed certificates.",
+											},
+											"name": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Name of the certificate.",
+											},
+										},
+									},
+									MarkdownDescription: "The certificates served for the entry.",
+								},
+								"hostname": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The hostname the entry serves - will be null for a matcher entry.",
+								},
+								"matcher": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The predefined matcher of the entry, such as `PRIMARY` - will be null for a hostname entry.",
+								},
+								"name": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Name of the certificate map entry.",
+								},
+								"state": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The serving state of the entry, such as `ACTIVE` or `PENDING`.",
+								},
+							},
+						},
+						MarkdownDescription: "The entries of the certificate map.",
+					},
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Identifier for the certificate map with format `projects/{{project}}/locations/global/certificateMaps/{{name}}`.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the certificate map.",
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Details about the certificate map attached to the target proxy - will be null if the proxy uses SSL certificates instead.",
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+		},
+		MarkdownDescription: "Finds the Certificate Manager certificate map attached, through the `networking.gke.io/certmap` annotation, to the target HTTPS or SSL proxy of the load balancer for a Kubernetes Gateway resource, along with its entries and the state of their certificates.",
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentLookups bounds the compute API calls a single read makes at once, to stay clear of the rate limits.
+const maxConcurrentLookups = 8
+
+// lookupResult is the outcome of one of the lookups run by lookupConcurrently.
+type lookupResult[T any] struct {
+	diags diag.Diagnostics
+	value T
+}
+
+// lookupConcurrently runs the lookup of each distinct key concurrently and returns the outcomes by key. Failed lookups
+// don't cancel the others, so the caller can report them in order, or skip them with partial results.
+func lookupConcurrently[K comparable, T any](ctx context.Context, keys []K, lookup func(context.Context, K) (T, diag.Diagnostics)) map[K]lookupResult[T] {
+	var mu sync.Mutex
+
+	results := make(map[K]lookupResult[T], len(keys))
+	seen := make(map[K]bool, len(keys))
+
+	group := &errgroup.Group{}
+	group.SetLimit(maxConcurrentLookups)
+
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		group.Go(func() error {
+			value, diags := lookup(ctx, key)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[key] = lookupResult[T]{
+				diags: diags,
+				value: value,
+			}
+
+			return nil
+		})
+	}
+
+	// The lookups report their failures through the diagnostics, never through the group.
+	_ = group.Wait()
+
+	return results
+}
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/auth/credentials"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+// GKEGatewayProviderModelExternalCredentials describes the external_credentials block of the provider.
+type GKEGatewayProviderModelExternalCredentials struct {
+	Audience               types.String `tfsdk:"audience"`
+	ServiceAccountEmail    types.String `tfsdk:"service_account_email"`
+	SubjectTokenFieldName  types.String `tfsdk:"subject_token_field_name"`
+	SubjectTokenFile       types.String `tfsdk:"subject_token_file"`
+	SubjectTokenType       types.String `tfsdk:"subject_token_type"`
+	SubjectTokenURL        types.String `tfsdk:"subject_token_url"`
+	SubjectTokenURLHeaders types.Map    `tfsdk:"subject_token_url_headers"`
+}
+
+// googleClientOptions returns the options authenticating the Google API clients. Without any credentials configured,
+// the clients fall back to Application Default Credentials. When a transport is given, both the API requests and the
+// requests fetching tokens go through it.
+func googleClientOptions(ctx context.Context, data GKEGatewayProviderModel, transport *http.Transport) ([]option.ClientOption, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The universe also applies to the IAM API minting the impersonated tokens.
+	universeOptions := []option.ClientOption{}
+	if !data.UniverseDomain.IsNull() {
+		universeOptions = append(universeOptions, option.WithUniverseDomain(data.UniverseDomain.ValueString()))
+	}
+
+	clientOptions := slices.Clip(universeOptions)
+
+	scopes := []string{}
+	diags.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Minting tokens needs the credentials to keep their default scopes, only the impersonated tokens are narrowed.
+	credentialsScopes := scopes
+	if len(scopes) == 0 || !data.ImpersonateServiceAccount.IsNull() {
+		credentialsScopes = []string{"https://www.googleapis.com/auth/cloud-platform"}
+	}
+
+	switch {
+	case !data.AccessToken.IsNull():
+		// The token isn't refreshed, so it must outlive the Terraform run.
+		clientOptions = append(clientOptions, option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: data.AccessToken.ValueString(),
+		})))
+	case !data.Credentials.IsNull():
+		credentialsJSON, err := readCredentials(data.Credentials.ValueString())
+		if err != nil {
+			diags.AddError("Unable to configure provider", fmt.Sprintf("Error reading credentials: %+v", err))
+			return nil, diags
+		}
+
+		if transport == nil {
+			clientOptions = append(clientOptions, option.WithAuthCredentialsJSON(option.ServiceAccount, credentialsJSON))
+			break
+		}
+
+		authCredentials, err := credentials.NewCredentialsFromJSON(credentials.ServiceAccount, credentialsJSON, tokenDetectOptions(data, credentialsScopes, transport))
+		if err != nil {
+			diags.AddError("Unable to configure provider", fmt.Sprintf("Error reading credentials: %+v", err))
+			return nil, diags
+		}
+
+		clientOptions = append(clientOptions, option.WithAuthCredentials(authCredentials))
+	case data.ExternalCredentials != nil:
+		credentialsJSON := externalAccountJSON(data.ExternalCredentials, data.UniverseDomain)
+
+		if transport == nil {
+			clientOptions = append(clientOptions, option.WithAuthCredentialsJSON(option.ExternalAccount, credentialsJSON))
+			break
+		}
+
+		authCredentials, err := credentials.NewCredentialsFromJSON(credentials.ExternalAccount, credentialsJSON, tokenDetectOptions(data, credentialsScopes, transport))
+		if err != nil {
+			diags.AddError("Unable to configure provider", fmt.Sprintf("Error reading external_credentials: %+v", err))
+			return nil, diags
+		}
+
+		clientOptions = append(clientOptions, option.WithAuthCredentials(authCredentials))
+	case transport != nil:
+		// The clients would otherwise find the Application Default Credentials themselves, fetching tokens without the
+		// transport.
+		authCredentials, err := credentials.DetectDefault(tokenDetectOptions(data, credentialsScopes, transport))
+		if err != nil {
+			diags.AddError("Unable to configure provider", fmt.Sprintf("Error finding Application Default Credentials: %+v", err))
+			return nil, diags
+		}
+
+		clientOptions = append(clientOptions, option.WithAuthCredentials(authCredentials))
+	}
+
+	if !data.ImpersonateServiceAccount.IsNull() {
+		delegates := []string{}
+		diags.Append(data.ImpersonateServiceAccountDelegates.ElementsAs(ctx, &delegates, false)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		impersonateOptions := clientOptions
+
+		if transport != nil {
+			client, err := googleHTTPClient(ctx, transport, clientOptions)
+			if err != nil {
+				diags.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google HTTP client: %+v", err))
+				return nil, diags
+			}
+
+			impersonateOptions = append(slices.Clip(universeOptions), option.WithHTTPClient(client))
+		}
+
+		if len(scopes) == 0 {
+			scopes = credentialsScopes
+		}
+
+		// The configured credentials only mint tokens for the impersonated service account.
+		tokenSource, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+			Delegates:       delegates,
+			Scopes:          scopes,
+			TargetPrincipal: data.ImpersonateServiceAccount.ValueString(),
+		}, impersonateOptions...)
+
+		if err != nil {
+			diags.AddError("Unable to configure provider", fmt.Sprintf("Error impersonating service account %s: %+v", data.ImpersonateServiceAccount.ValueString(), err))
+			return nil, diags
+		}
+
+		clientOptions = append(slices.Clip(universeOptions), option.WithTokenSource(tokenSource))
+	} else if len(scopes) > 0 {
+		clientOptions = append(clientOptions, option.WithScopes(scopes...))
+	}
+
+	// Quota and billing are charged to the project of the resources unless overridden.
+	if data.UserProjectOverride.ValueBool() {
+		billingProject := data.Project.ValueString()
+		if !data.BillingProject.IsNull() {
+			billingProject = data.BillingProject.ValueString()
+		}
+
+		clientOptions = append(clientOptions, option.WithQuotaProject(billingProject))
+	}
+
+	if transport == nil && !data.DebugLogging.ValueBool() && data.RequestTimeout.IsNull() {
+		return clientOptions, diags
+	}
+
+	client, err := googleHTTPClient(ctx, transport, clientOptions)
+	if err != nil {
+		diags.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google HTTP client: %+v", err))
+		return nil, diags
+	}
+
+	if !data.RequestTimeout.IsNull() {
+		timeout, timeoutDiags := parseRequestTimeout(data.RequestTimeout)
+		diags.Append(timeoutDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		client.Timeout = timeout
+	}
+
+	if data.DebugLogging.ValueBool() {
+		client.Transport = &loggingTransport{base: client.Transport}
+	}
+
+	clientOptions = append(slices.Clip(universeOptions), option.WithHTTPClient(client))
+
+	return clientOptions, diags
+}
+
+// googleHTTPClient returns an HTTP client authenticated by the options, on top of the transport when one is given. The
+// clients ignore the other options when given an HTTP client, so it must carry the authentication itself. The
+// cloud-platform scope is the default of the clients, which they can't add to a client built here. Building it like the
+// clients do also keeps the client certificate used for the mTLS endpoints without a transport, such as a device
+// certificate when GOOGLE_API_USE_CLIENT_CERTIFICATE is set.
+func googleHTTPClient(ctx context.Context, transport *http.Transport, clientOptions []option.ClientOption) (*http.Client, error) {
+	clientOptions = append([]option.ClientOption{option.WithScopes("https://www.googleapis.com/auth/cloud-platform")}, clientOptions...)
+
+	if transport == nil {
+		client, _, err := htransport.NewClient(ctx, clientOptions...)
+		return client, err
+	}
+
+	roundTripper, err := htransport.NewTransport(ctx, transport, clientOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: roundTripper}, nil
+}
+
+// tokenDetectOptions returns the options of the credentials, fetching their tokens through the transport.
+func tokenDetectOptions(data GKEGatewayProviderModel, scopes []string, transport *http.Transport) *credentials.DetectOptions {
+	return &credentials.DetectOptions{
+		Client:         &http.Client{Transport: transport},
+		Scopes:         scopes,
+		UniverseDomain: data.UniverseDomain.ValueString(),
+	}
+}
+
+// externalAccountJSON returns the workload identity federation configuration of the external credentials, in the
+// format written by `gcloud iam workload-identity-pools create-cred-config`.
+func externalAccountJSON(externalCredentials *GKEGatewayProviderModelExternalCredentials, universeDomain types.String) []byte {
+	domain := "googleapis.com"
+	if !universeDomain.IsNull() {
+		domain = universeDomain.ValueString()
+	}
+
+	credentialSource := map[string]any{}
+
+	if !externalCredentials.SubjectTokenFile.IsNull() {
+		credentialSource["file"] = externalCredentials.SubjectTokenFile.ValueString()
+	} else {
+		headers := map[string]string{}
+		for name, value := range externalCredentials.SubjectTokenURLHeaders.Elements() {
+			headers[name] = value.(types.String).ValueString()
+		}
+
+		credentialSource["headers"] = headers
+		credentialSource["url"] = externalCredentials.SubjectTokenURL.ValueString()
+	}
+
+	// Sources such as the GitHub Actions token endpoint wrap the token in a JSON response.
+	if !externalCredentials.SubjectTokenFieldName.IsNull() {
+		credentialSource["format"] = map[string]string{
+			"subject_token_field_name": externalCredentials.SubjectTokenFieldName.ValueString(),
+			"type":                     "json",
+		}
+	}
+
+	subjectTokenType := "urn:ietf:params:oauth:token-type:jwt"
+	if !externalCredentials.SubjectTokenType.IsNull() {
+		subjectTokenType = externalCredentials.SubjectTokenType.ValueString()
+	}
+
+	config := map[string]any{
+		"audience":           externalCredentials.Audience.ValueString(),
+		"credential_source":  credentialSource,
+		"subject_token_type": subjectTokenType,
+		"token_url":          fmt.Sprintf("https://sts.%s/v1/token", domain),
+		"type":               "external_account",
+		"universe_domain":    domain,
+	}
+
+	if !externalCredentials.ServiceAccountEmail.IsNull() {
+		config["service_account_impersonation_url"] = fmt.Sprintf("https://iamcredentials.%s/v1/projects/-/serviceAccounts/%s:generateAccessToken", domain, externalCredentials.ServiceAccountEmail.ValueString())
+	}
+
+	// Marshalling maps of strings can't fail.
+	configJSON, _ := json.Marshal(config)
+
+	return configJSON
+}
+
+// parseRequestTimeout parses the deadline of each request to the Google APIs.
+func parseRequestTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", fmt.Sprintf("The request_timeout must be a duration such as `30s`: %s", err))
+		return 0, diags
+	}
+
+	if timeout <= 0 {
+		diags.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", "The request_timeout must be positive.")
+		return 0, diags
+	}
+
+	return timeout, diags
+}
+
+// readCredentials returns the service account key, which is either given inline as JSON or as the path of a key file.
+func readCredentials(credentials string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(credentials), "{") {
+		return []byte(credentials), nil
+	}
+
+	return os.ReadFile(credentials)
+}
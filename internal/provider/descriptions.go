@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// descriptionFormat is one of the layouts of the JSON descriptions the controllers write on the forwarding rules of the
+// gateways. Supporting another layout only takes adding it to descriptionFormats.
+type descriptionFormat struct {
+	// key returns the key of the description the format reads, given the description_key of the provider.
+	key func(descriptionKey string) string
+	// kind is the kind of Kubernetes resource the format references, either gateway or ingress.
+	kind string
+	// name identifies the format, as reported by the data sources.
+	name string
+	// parse returns the namespace and name of the gateway the value under the key references, reporting whether the
+	// value has the layout of the format. Values referencing another kind of resource have the layout, but no gateway.
+	parse func(value string) (string, string, bool)
+	// uidKey returns the key of the description holding the Kubernetes UID of the gateway, given the description_key of
+	// the provider.
+	uidKey func(descriptionKey string) string
+}
+
+// descriptionFormats are the known layouts of the descriptions, tried in order.
+var descriptionFormats = []descriptionFormat{
+	{
+		// GKE references the path of the gateway, as /namespaces/{{namespace}}/gateways/{{name}}.
+		key:  func(descriptionKey string) string { return descriptionKey },
+		kind: "gateway",
+		name: "k8s-resource-path",
+		parse: func(value string) (string, string, bool) {
+			components := strings.Split(value, "/")
+
+			if len(components) != 5 || components[0] != "" || components[1] != "namespaces" || components[2] == "" || components[4] == "" {
+				return "", "", false
+			}
+
+			if components[3] != "gateways" {
+				return "", "", true
+			}
+
+			return components[2], components[4], true
+		},
+		uidKey: func(descriptionKey string) string { return descriptionKey + "UID" },
+	},
+	{
+		// Like GKE does on the backend services, as {{namespace}}/{{name}}.
+		key:    func(descriptionKey string) string { return "kubernetes.io/gateway-name" },
+		kind:   "gateway",
+		name:   "kubernetes-io-gateway-name",
+		parse:  parseNamespacedName,
+		uidKey: func(descriptionKey string) string { return "kubernetes.io/gateway-uid" },
+	},
+	{
+		// The GKE Ingress controller, which names the rules k8s2-fr-... and k8s2-fs-..., references the Ingress as
+		// {{namespace}}/{{name}}.
+		key:    func(descriptionKey string) string { return "kubernetes.io/ingress-name" },
+		kind:   "ingress",
+		name:   "kubernetes-io-ingress-name",
+		parse:  parseNamespacedName,
+		uidKey: func(descriptionKey string) string { return "kubernetes.io/ingress-uid" },
+	},
+}
+
+// parseNamespacedName parses a {{namespace}}/{{name}} reference.
+func parseNamespacedName(value string) (string, string, bool) {
+	namespace, name, ok := strings.Cut(value, "/")
+
+	return namespace, name, ok && namespace != "" && name != "" && !strings.Contains(name, "/")
+}
+
+// gatewayDescription is the Kubernetes gateway, or Ingress, a forwarding rule description references, along with the
+// name of the format it was recognized by.
+type gatewayDescription struct {
+	format    string
+	gateway   string
+	kind      string
+	namespace string
+	uid       string
+}
+
+// parseGatewayDescription returns the Kubernetes gateway the JSON description references, or nil when it isn't the
+// description of a gateway forwarding rule. The error reports descriptions holding one of the keys of the known formats
+// in a layout none of them recognizes, such as after a controller upgrade.
+func parseGatewayDescription(description string, descriptionKey string) (*gatewayDescription, error) {
+	// Most rules won't have a JSON description.
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(description), &fields); err != nil {
+		return nil, nil
+	}
+
+	var unrecognized []string
+
+	for _, format := range descriptionFormats {
+		key := format.key(descriptionKey)
+
+		raw, ok := fields[key]
+		if !ok {
+			continue
+		}
+
+		if value, ok := raw.(string); ok {
+			if namespace, gateway, ok := format.parse(value); ok {
+				if gateway == "" {
+					return nil, nil
+				}
+
+				// Older controllers don't embed the UID.
+				uid, _ := fields[format.uidKey(descriptionKey)].(string)
+
+				return &gatewayDescription{
+					format:    format.name,
+					gateway:   gateway,
+					kind:      format.kind,
+					namespace: namespace,
+					uid:       uid,
+				}, nil
+			}
+		}
+
+		unrecognized = append(unrecognized, key)
+	}
+
+	if len(unrecognized) > 0 {
+		return nil, fmt.Errorf("the %s key doesn't have the layout of any known description format", strings.Join(unrecognized, " and "))
+	}
+
+	return nil, nil
+}
+
+// descriptionForwardingRulesFilter returns the list filter matching the descriptions holding the key of any known format,
+// so the ones in an unrecognized layout are still listed and reported. The compute API matches the eq operator as a RE2
+// expression covering the whole field.
+func descriptionForwardingRulesFilter(descriptionKey string) string {
+	keys := []string{}
+	for _, format := range descriptionFormats {
+		keys = append(keys, regexp.QuoteMeta(format.key(descriptionKey)))
+	}
+
+	return fmt.Sprintf(`description eq '.*"(%s)"\s*:.*'`, strings.Join(keys, "|"))
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestScanGatewayForwardingRulesDescriptions checks the forwarding rules listed by the compute API are paired with the
+// Kubernetes resource their description references in each of the known formats, and the descriptions in an unknown
+// layout are reported rather than ignored.
+func TestScanGatewayForwardingRulesDescriptions(t *testing.T) {
+	tests := map[string]struct {
+		descriptionKey string
+		description    string
+		expected       *gatewayForwardingRule
+		warning        string
+	}{
+		"k8s-resource-path": {
+			description: `{"k8sResource":"/namespaces/my-cool-app/gateways/my-gateway-name","k8sResourceUID":"0a1b2c3d"}`,
+			expected:    &gatewayForwardingRule{format: "k8s-resource-path", gateway: "my-gateway-name", kind: "gateway", namespace: "my-cool-app", uid: "0a1b2c3d"},
+		},
+		"k8s-resource-path without UID": {
+			description: `{"k8sResource":"/namespaces/my-cool-app/gateways/my-gateway-name"}`,
+			expected:    &gatewayForwardingRule{format: "k8s-resource-path", gateway: "my-gateway-name", kind: "gateway", namespace: "my-cool-app"},
+		},
+		"description_key": {
+			descriptionKey: "example.com/gateway",
+			description:    `{"example.com/gateway":"/namespaces/my-cool-app/gateways/my-gateway-name","example.com/gatewayUID":"0a1b2c3d"}`,
+			expected:       &gatewayForwardingRule{format: "k8s-resource-path", gateway: "my-gateway-name", kind: "gateway", namespace: "my-cool-app", uid: "0a1b2c3d"},
+		},
+		"kubernetes-io-gateway-name": {
+			description: `{"kubernetes.io/gateway-name":"my-cool-app/my-gateway-name","kubernetes.io/gateway-uid":"0a1b2c3d"}`,
+			expected:    &gatewayForwardingRule{format: "kubernetes-io-gateway-name", gateway: "my-gateway-name", kind: "gateway", namespace: "my-cool-app", uid: "0a1b2c3d"},
+		},
+		"kubernetes-io-ingress-name": {
+			description: `{"kubernetes.io/ingress-name":"my-cool-app/my-ingress-name"}`,
+			expected:    &gatewayForwardingRule{format: "kubernetes-io-ingress-name", gateway: "my-ingress-name", kind: "ingress", namespace: "my-cool-app"},
+		},
+		"other kind of resource": {
+			description: `{"k8sResource":"/namespaces/my-cool-app/services/my-service"}`,
+		},
+		"plain text": {
+			description: "Created by hand",
+		},
+		"unrecognized layout": {
+			description: `{"k8sResource":"my-cool-app/my-gateway-name"}`,
+			warning:     "the k8sResource key doesn't have the layout of any known description format",
+		},
+		"unrecognized value": {
+			description: `{"kubernetes.io/gateway-name":{"namespace":"my-cool-app"}}`,
+			warning:     "the kubernetes.io/gateway-name key doesn't have the layout of any known description format",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			forwardingRule := &computepb.ForwardingRule{
+				Description: proto.String(test.description),
+				Name:        proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			}
+
+			server := testAccComputeServer(t, map[string]proto.Message{
+				"projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd": forwardingRule,
+			})
+
+			values := map[string]tftypes.Value{}
+			if test.descriptionKey != "" {
+				values["description_key"] = tftypes.NewValue(tftypes.String, test.descriptionKey)
+			}
+
+			p := testComputeProviderData(t, server, values)
+
+			gatewayForwardingRules, diags := p.scanGatewayForwardingRules(context.Background(), "my-gcp-project", types.StringNull())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if test.warning == "" && diags.WarningsCount() > 0 {
+				t.Errorf("unexpected warning: %v", diags)
+			}
+
+			if test.warning != "" && (diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), test.warning)) {
+				t.Errorf("expected a warning containing %q, got %v", test.warning, diags)
+			}
+
+			if test.expected == nil {
+				if len(gatewayForwardingRules) > 0 {
+					t.Errorf("expected no gateway forwarding rule, got %+v", gatewayForwardingRules)
+				}
+
+				return
+			}
+
+			if len(gatewayForwardingRules) != 1 {
+				t.Fatalf("expected a gateway forwarding rule, got %+v", gatewayForwardingRules)
+			}
+
+			got := gatewayForwardingRules[0]
+
+			if got.forwardingRule.GetName() != forwardingRule.GetName() {
+				t.Errorf("expected forwarding rule %s, got %s", forwardingRule.GetName(), got.forwardingRule.GetName())
+			}
+
+			got.forwardingRule = nil
+
+			if got != *test.expected {
+				t.Errorf("expected %+v, got %+v", *test.expected, got)
+			}
+		})
+	}
+}
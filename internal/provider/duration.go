@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// formatComputeDuration renders a compute duration the way the API documents them, in seconds such as `3600s` or
+// `1.5s`, or null when it isn't set.
+func formatComputeDuration(duration *computepb.Duration) types.String {
+	if duration == nil {
+		return types.StringNull()
+	}
+
+	if duration.GetNanos() == 0 {
+		return types.StringValue(fmt.Sprintf("%ds", duration.GetSeconds()))
+	}
+
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", duration.GetNanos()), "0")
+
+	return types.StringValue(fmt.Sprintf("%d.%ss", duration.GetSeconds(), fraction))
+}
+
+// parseComputeDuration parses a Go duration string, such as `3600s` or `1h`, into a compute duration.
+func parseComputeDuration(value string) (*computepb.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := int64(duration / time.Second)
+	nanos := int32(duration % time.Second)
+
+	return &computepb.Duration{
+		Nanos:   &nanos,
+		Seconds: &seconds,
+	}, nil
+}
+
+// equalComputeDuration reports whether the Go duration string denotes the same length of time as the compute duration,
+// so that `1h` and `3600s` don't produce a diff.
+func equalComputeDuration(value string, duration *computepb.Duration) bool {
+	parsed, err := parseComputeDuration(value)
+	if err != nil || duration == nil {
+		return false
+	}
+
+	return parsed.GetSeconds() == duration.GetSeconds() && parsed.GetNanos() == duration.GetNanos()
+}
@@ -0,0 +1,587 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GatewayDataSource{}
+
+func NewGatewayDataSource() datasource.DataSource {
+	return &GatewayDataSource{}
+}
+
+// GatewayDataSource defines the data source implementation.
+type GatewayDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// GatewayDataSourceModel describes the data source data model.
+type GatewayDataSourceModel struct {
+	BackendBuckets  []GatewayDataSourceModelComponent      `tfsdk:"backend_buckets"`
+	BackendServices []GatewayDataSourceModelBackendService `tfsdk:"backend_services"`
+	Errors          []GatewayDataSourceModelError          `tfsdk:"errors"`
+	ForwardingRules []GatewayDataSourceModelForwardingRule `tfsdk:"forwarding_rules"`
+	Gateway         types.String                           `tfsdk:"gateway"`
+	HealthChecks    []GatewayDataSourceModelComponent      `tfsdk:"health_checks"`
+	Namespace       types.String                           `tfsdk:"namespace"`
+	Network         types.String                           `tfsdk:"network"`
+	PartialResults  types.Bool                             `tfsdk:"partial_results"`
+	Project         types.String                           `tfsdk:"project"`
+	Region          types.String                           `tfsdk:"region"`
+	Subnetwork      types.String                           `tfsdk:"subnetwork"`
+	TargetProxies   []GatewayDataSourceModelTargetProxy    `tfsdk:"target_proxies"`
+	Topology        types.String                           `tfsdk:"topology"`
+	UrlMaps         []GatewayDataSourceModelUrlMap         `tfsdk:"url_maps"`
+}
+
+type GatewayDataSourceModelBackendService struct {
+	ID                types.String `tfsdk:"id"`
+	MaxStreamDuration types.String `tfsdk:"max_stream_duration"`
+	Name              types.String `tfsdk:"name"`
+	Protocol          types.String `tfsdk:"protocol"`
+	SelfLink          types.String `tfsdk:"self_link"`
+}
+
+type GatewayDataSourceModelComponent struct {
+	Name     types.String `tfsdk:"name"`
+	SelfLink types.String `tfsdk:"self_link"`
+}
+
+type GatewayDataSourceModelError struct {
+	Component types.String `tfsdk:"component"`
+	Detail    types.String `tfsdk:"detail"`
+	Summary   types.String `tfsdk:"summary"`
+}
+
+type GatewayDataSourceModelForwardingRule struct {
+	IPAddress types.String `tfsdk:"ip_address"`
+	Name      types.String `tfsdk:"name"`
+	PortRange types.String `tfsdk:"port_range"`
+	SelfLink  types.String `tfsdk:"self_link"`
+}
+
+type GatewayDataSourceModelRouteRule struct {
+	MaxStreamDuration types.String `tfsdk:"max_stream_duration"`
+	PathMatcher       types.String `tfsdk:"path_matcher"`
+	Priority          types.Int64  `tfsdk:"priority"`
+}
+
+type GatewayDataSourceModelTargetProxy struct {
+	Name     types.String `tfsdk:"name"`
+	SelfLink types.String `tfsdk:"self_link"`
+	Type     types.String `tfsdk:"type"`
+}
+
+type GatewayDataSourceModelUrlMap struct {
+	Name       types.String                      `tfsdk:"name"`
+	RouteRules []GatewayDataSourceModelRouteRule `tfsdk:"route_rules"`
+	SelfLink   types.String                      `tfsdk:"self_link"`
+}
+
+func (d *GatewayDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *GatewayDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway"
+}
+
+func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data GatewayDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRules, diags := d.providerData.findGatewayForwardingRules(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRules = filterForwardingRulesByNetwork(forwardingRules, data.Network, data.Subnetwork)
+
+	if len(forwardingRules) == 0 {
+		return
+	}
+
+	data.BackendBuckets = []GatewayDataSourceModelComponent{}
+	data.BackendServices = []GatewayDataSourceModelBackendService{}
+	data.Errors = []GatewayDataSourceModelError{}
+	data.ForwardingRules = []GatewayDataSourceModelForwardingRule{}
+	data.HealthChecks = []GatewayDataSourceModelComponent{}
+	data.TargetProxies = []GatewayDataSourceModelTargetProxy{}
+	data.UrlMaps = []GatewayDataSourceModelUrlMap{}
+
+	// With partial_results, a component that fails to resolve is reported and skipped instead of failing the read.
+	resolved := func(component string, diags diag.Diagnostics) bool {
+		if !diags.HasError() || !data.PartialResults.ValueBool() {
+			resp.Diagnostics.Append(diags...)
+			return !diags.HasError()
+		}
+
+		for _, diagnostic := range diags {
+			if diagnostic.Severity() != diag.SeverityError {
+				resp.Diagnostics.Append(diagnostic)
+				continue
+			}
+
+			data.Errors = append(data.Errors, GatewayDataSourceModelError{
+				Component: types.StringValue(component),
+				Detail:    types.StringValue(diagnostic.Detail()),
+				Summary:   types.StringValue(diagnostic.Summary()),
+			})
+
+			resp.Diagnostics.AddWarning(diagnostic.Summary(), fmt.Sprintf("Skipping %s: %s", component, diagnostic.Detail()))
+		}
+
+		return false
+	}
+
+	// Listeners can share components, so each one is only looked up and reported once.
+	seen := map[string]bool{}
+	topology := &gatewayTopology{
+		Edges: []gatewayTopologyEdge{},
+		Nodes: []gatewayTopologyNode{},
+	}
+
+	// Each level of components is only known once the level above it is resolved, the components of a level are looked
+	// up concurrently. The results are then walked in order, so the attributes don't depend on which call returns first.
+	proxies := lookupConcurrently(ctx, forwardingRules, func(ctx context.Context, forwardingRule *computepb.ForwardingRule) (*targetProxy, diag.Diagnostics) {
+		return d.providerData.getTargetProxy(ctx, project, region, forwardingRule)
+	})
+
+	urlMapPaths := []string{}
+	for _, result := range proxies {
+		if !result.diags.HasError() && result.value.service == "" && result.value.urlMap != "" {
+			urlMapPaths = append(urlMapPaths, result.value.urlMap)
+		}
+	}
+
+	urlMaps := lookupConcurrently(ctx, urlMapPaths, func(ctx context.Context, urlMapPath string) (*computepb.UrlMap, diag.Diagnostics) {
+		return d.providerData.getUrlMapByPath(ctx, project, region, urlMapPath)
+	})
+
+	backendServicePaths := []string{}
+	for _, result := range proxies {
+		if !result.diags.HasError() && result.value.service != "" {
+			backendServicePaths = append(backendServicePaths, result.value.service)
+		}
+	}
+
+	for _, result := range urlMaps {
+		if !result.diags.HasError() {
+			for _, backendServicePath := range urlMapBackendServicePaths(result.value) {
+				backendServicePaths = append(backendServicePaths, *backendServicePath)
+			}
+		}
+	}
+
+	backendServices := lookupConcurrently(ctx, backendServicePaths, func(ctx context.Context, backendServicePath string) (*computepb.BackendService, diag.Diagnostics) {
+		return d.providerData.getBackendService(ctx, project, region, backendServicePath)
+	})
+
+	for _, forwardingRule := range forwardingRules {
+		data.ForwardingRules = append(data.ForwardingRules, GatewayDataSourceModelForwardingRule{
+			IPAddress: types.StringValue(forwardingRule.GetIPAddress()),
+			Name:      types.StringValue(forwardingRule.GetName()),
+			PortRange: types.StringValue(forwardingRule.GetPortRange()),
+			SelfLink:  types.StringValue(forwardingRule.GetSelfLink()),
+		})
+
+		topology.addNode("forwardingRules", forwardingRule.GetName(), forwardingRule.GetSelfLink())
+
+		proxy := proxies[forwardingRule].value
+
+		if !resolved(forwardingRule.GetTarget(), proxies[forwardingRule].diags) {
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			continue
+		}
+
+		topology.addEdge(forwardingRule.GetSelfLink(), proxy.selfLink)
+
+		if seen[proxy.selfLink] {
+			continue
+		}
+
+		seen[proxy.selfLink] = true
+
+		data.TargetProxies = append(data.TargetProxies, GatewayDataSourceModelTargetProxy{
+			Name:     types.StringValue(proxy.name),
+			SelfLink: types.StringValue(proxy.selfLink),
+			Type:     types.StringValue(proxy.kind),
+		})
+
+		topology.addNode(proxy.kind, proxy.name, proxy.selfLink)
+
+		// TCP and SSL proxies point straight at a backend service, the others go through a URL map.
+		parent, backendServicePaths := proxy.selfLink, []*string{}
+
+		if proxy.service != "" {
+			backendServicePaths = append(backendServicePaths, &proxy.service)
+		} else if proxy.urlMap != "" {
+			topology.addEdge(proxy.selfLink, proxy.urlMap)
+
+			if seen[proxy.urlMap] {
+				continue
+			}
+
+			seen[proxy.urlMap] = true
+
+			urlMap := urlMaps[proxy.urlMap].value
+
+			if !resolved(proxy.urlMap, urlMaps[proxy.urlMap].diags) {
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				continue
+			}
+
+			routeRules := []GatewayDataSourceModelRouteRule{}
+			for _, matcher := range urlMap.GetPathMatchers() {
+				for _, rule := range matcher.GetRouteRules() {
+					routeRules = append(routeRules, GatewayDataSourceModelRouteRule{
+						MaxStreamDuration: formatComputeDuration(rule.GetRouteAction().GetMaxStreamDuration()),
+						PathMatcher:       types.StringValue(matcher.GetName()),
+						Priority:          types.Int64Value(int64(rule.GetPriority())),
+					})
+				}
+			}
+
+			data.UrlMaps = append(data.UrlMaps, GatewayDataSourceModelUrlMap{
+				Name:       types.StringValue(urlMap.GetName()),
+				RouteRules: routeRules,
+				SelfLink:   types.StringValue(urlMap.GetSelfLink()),
+			})
+
+			topology.addNode("urlMaps", urlMap.GetName(), urlMap.GetSelfLink())
+
+			parent, backendServicePaths = urlMap.GetSelfLink(), urlMapBackendServicePaths(urlMap)
+
+			// Backend buckets serve static content from Cloud Storage and have nothing behind them.
+			for _, backendBucketPath := range urlMapBackendBucketPaths(urlMap) {
+				topology.addEdge(urlMap.GetSelfLink(), *backendBucketPath)
+
+				if seen[*backendBucketPath] {
+					continue
+				}
+
+				seen[*backendBucketPath] = true
+
+				backendBucketComponents := strings.Split(*backendBucketPath, "/")
+
+				data.BackendBuckets = append(data.BackendBuckets, GatewayDataSourceModelComponent{
+					Name:     types.StringValue(backendBucketComponents[len(backendBucketComponents)-1]),
+					SelfLink: types.StringValue(*backendBucketPath),
+				})
+
+				topology.addNode("backendBuckets", backendBucketComponents[len(backendBucketComponents)-1], *backendBucketPath)
+			}
+		}
+
+		for _, backendServicePath := range backendServicePaths {
+			topology.addEdge(parent, *backendServicePath)
+
+			if seen[*backendServicePath] {
+				continue
+			}
+
+			seen[*backendServicePath] = true
+
+			backendService := backendServices[*backendServicePath].value
+
+			if !resolved(*backendServicePath, backendServices[*backendServicePath].diags) {
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				continue
+			}
+
+			data.BackendServices = append(data.BackendServices, GatewayDataSourceModelBackendService{
+				ID:                types.StringValue(strconv.FormatUint(backendService.GetId(), 10)),
+				MaxStreamDuration: formatComputeDuration(backendService.GetMaxStreamDuration()),
+				Name:              types.StringValue(backendService.GetName()),
+				Protocol:          types.StringValue(backendService.GetProtocol()),
+				SelfLink:          types.StringValue(backendService.GetSelfLink()),
+			})
+
+			topology.addNode("backendServices", backendService.GetName(), backendService.GetSelfLink())
+
+			for _, healthCheck := range backendService.GetHealthChecks() {
+				topology.addEdge(backendService.GetSelfLink(), healthCheck)
+
+				if seen[healthCheck] {
+					continue
+				}
+
+				seen[healthCheck] = true
+
+				healthCheckComponents := strings.Split(healthCheck, "/")
+
+				data.HealthChecks = append(data.HealthChecks, GatewayDataSourceModelComponent{
+					Name:     types.StringValue(healthCheckComponents[len(healthCheckComponents)-1]),
+					SelfLink: types.StringValue(healthCheck),
+				})
+
+				topology.addNode("healthChecks", healthCheckComponents[len(healthCheckComponents)-1], healthCheck)
+			}
+		}
+	}
+
+	data.Topology = types.StringValue(topology.String())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *GatewayDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backend_buckets": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the backend bucket.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the backend bucket.",
+						},
+					},
+				},
+				MarkdownDescription: "The backend buckets referenced by the URL maps - will be null if no forwarding rule is found.",
+			},
+			"backend_services": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier for the backend service.",
+						},
+						"max_stream_duration": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The maximum duration of a stream, such as a long-lived gRPC call, before it is closed - will be null if unlimited.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the backend service.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The protocol the load balancer uses to talk to the backends, such as `HTTP`, `HTTPS`, `HTTP2` or `H2C`.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the backend service.",
+						},
+					},
+				},
+				MarkdownDescription: "The backend services referenced by the URL maps - will be null if no forwarding rule is found.",
+			},
+			"errors": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"component": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the component that failed to resolve.",
+						},
+						"detail": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Details of the error.",
+						},
+						"summary": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Summary of the error.",
+						},
+					},
+				},
+				MarkdownDescription: "The components that failed to resolve when `partial_results` is set, whose dependents are missing from the other attributes - always empty otherwise.",
+			},
+			"forwarding_rules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IP address the forwarding rule serves.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the forwarding rule.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the forwarding rule.",
+						},
+						"port_range": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Port range the forwarding rule serves.",
+						},
+					},
+				},
+				MarkdownDescription: "The forwarding rules created for the gateway, one per listener address - will be null if none is found.",
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"health_checks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the health check.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the health check.",
+						},
+					},
+				},
+				MarkdownDescription: "The health checks used by the backend services - will be null if no forwarding rule is found.",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Name, self_link or ID of the VPC network the forwarding rule of the gateway must be in, to tell apart gateways with the same name in clusters on different networks. Only internal load balancers have a network.",
+				Optional:            true,
+			},
+			"partial_results": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return the components that resolved, with warnings and `errors` for the others, when some fail to resolve, for instance because of missing permissions. Defaults to `false`, failing the whole read.",
+				Optional:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"subnetwork": schema.StringAttribute{
+				MarkdownDescription: "Name, self_link or ID of the subnetwork the forwarding rule of the gateway must be in. Only internal load balancers have a subnetwork.",
+				Optional:            true,
+			},
+			"target_proxies": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the target proxy.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the target proxy.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the target proxy, such as `targetHttpsProxies`.",
+						},
+					},
+				},
+				MarkdownDescription: "The target proxies of the forwarding rules - will be null if no forwarding rule is found.",
+			},
+			"topology": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The components as a JSON graph document, with `nodes` made of an `id` (the self_link), `name` and `type` (such as `urlMaps`) and `edges` from the component referencing another to the referenced one, each made of a `from` and `to` node id - will be null if no forwarding rule is found.",
+			},
+			"url_maps": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the URL map.",
+						},
+						"route_rules": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"max_stream_duration": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The maximum duration of a stream matched by the rule before it is closed - will be null if the backend service setting applies.",
+									},
+									"path_matcher": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Name of the path matcher the rule belongs to.",
+									},
+									"priority": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "Priority of the rule within its path matcher.",
+									},
+								},
+							},
+							MarkdownDescription: "The route rules of the URL map, generated by GKE from the rules of the routes attached to the gateway.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the URL map.",
+						},
+					},
+				},
+				MarkdownDescription: "The URL maps served by the target proxies - will be null if no forwarding rule is found.",
+			},
+		},
+		MarkdownDescription: "Finds every component of the load balancer created from a Kubernetes Gateway resource by GKE: the forwarding rules, target proxies, URL maps, backend services and buckets, and health checks. Unlike `gkegateway_backend_service`, gateways with several listeners or backend services are supported.",
+	}
+}
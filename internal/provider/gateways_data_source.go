@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GatewaysDataSource{}
+
+func NewGatewaysDataSource() datasource.DataSource {
+	return &GatewaysDataSource{}
+}
+
+// GatewaysDataSource defines the data source implementation.
+type GatewaysDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// GatewaysDataSourceModel describes the data source data model.
+type GatewaysDataSourceModel struct {
+	Gateways []GatewaysDataSourceModelGateway `tfsdk:"gateways"`
+	Project  types.String                     `tfsdk:"project"`
+	Region   types.String                     `tfsdk:"region"`
+}
+
+type GatewaysDataSourceModelGateway struct {
+	ForwardingRule types.String `tfsdk:"forwarding_rule"`
+	Gateway        types.String `tfsdk:"gateway"`
+	IPAddress      types.String `tfsdk:"ip_address"`
+	Namespace      types.String `tfsdk:"namespace"`
+}
+
+func (d *GatewaysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *GatewaysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateways"
+}
+
+func (d *GatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data GatewaysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayForwardingRules, diags := d.providerData.listGatewayForwardingRules(ctx, project, region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A gateway with several listeners is reported once per forwarding rule.
+	data.Gateways = []GatewaysDataSourceModelGateway{}
+
+	for _, gfr := range gatewayForwardingRules {
+		data.Gateways = append(data.Gateways, GatewaysDataSourceModelGateway{
+			ForwardingRule: types.StringValue(gfr.forwardingRule.GetName()),
+			Gateway:        types.StringValue(gfr.gateway),
+			IPAddress:      types.StringValue(gfr.forwardingRule.GetIPAddress()),
+			Namespace:      types.StringValue(gfr.namespace),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *GatewaysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateways": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"forwarding_rule": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the forwarding rule.",
+						},
+						"gateway": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the Kubernetes gateway resource.",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IP address the forwarding rule serves on.",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+						},
+					},
+				},
+				MarkdownDescription: "The forwarding rules created by GKE for Kubernetes Gateway resources, one entry per forwarding rule.",
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to search. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to search. If it is not provided, the provider region is used. When neither are provided, global load balancers are searched.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+		},
+		MarkdownDescription: "Lists the Kubernetes Gateway resources GKE has created a load balancer for in a project, either globally or in a region, based on the description GKE writes on the forwarding rules.",
+	}
+}
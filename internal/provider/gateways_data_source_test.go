@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGatewaysDataSourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// missing fields
+			{
+				Config: `
+					data "gkegateway_gateways" "example" {
+						region = "us-central1"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The project field must be set on either the provider or data source.`),
+			},
+		},
+	})
+}
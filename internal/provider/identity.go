@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GatewayResourceIdentityModel describes the identity of the resources managing part of the load balancer of a
+// Kubernetes gateway, which is stable for the lifetime of the resource, unlike the self_links of the load balancer
+// components GKE may recreate. Resources identified by more than their gateway extend it.
+type GatewayResourceIdentityModel struct {
+	Gateway   types.String `tfsdk:"gateway"`
+	Namespace types.String `tfsdk:"namespace"`
+	Project   types.String `tfsdk:"project"`
+	Region    types.String `tfsdk:"region"`
+}
+
+// gatewayIdentityAttributes returns the attributes of GatewayResourceIdentityModel.
+func gatewayIdentityAttributes() map[string]identityschema.Attribute {
+	return map[string]identityschema.Attribute{
+		"gateway": identityschema.StringAttribute{
+			Description:       "Name of the Kubernetes gateway resource.",
+			RequiredForImport: true,
+		},
+		"namespace": identityschema.StringAttribute{
+			Description:       "Name of the Kubernetes namespace the gateway resource is in.",
+			RequiredForImport: true,
+		},
+		"project": identityschema.StringAttribute{
+			Description:       "The ID of the project in which the load balancer belongs.",
+			RequiredForImport: true,
+		},
+		"region": identityschema.StringAttribute{
+			Description:       "The region in which the load balancer belongs, or null for the global load balancers.",
+			OptionalForImport: true,
+		},
+	}
+}
+
+// setGatewayIdentity sets the identity of the resource of the gateway in its resolved scope, so it doesn't change along
+// with the defaults of the provider.
+func (p *GKEGatewayProviderData) setGatewayIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, project types.String, region types.String, namespace types.String, gateway types.String) diag.Diagnostics {
+	resolvedProject, resolvedRegion, diags := p.resolveScope(project, region)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("gateway"), gateway)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("project"), resolvedProject)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("region"), resolvedRegion)...)
+
+	return diags
+}
+
+// unresolveScope returns the project and region to save into the state of a resource in the given scope, leaving null
+// the ones matching the defaults of the provider, so configurations relying on the defaults don't plan to replace the
+// imported resources.
+func (p *GKEGatewayProviderData) unresolveScope(project types.String, region types.String) (types.String, types.String) {
+	if p == nil {
+		return project, region
+	}
+
+	if !p.project.IsNull() && project.Equal(p.project) {
+		project = types.StringNull()
+	}
+
+	if !p.region.IsNull() && region.Equal(p.region) {
+		region = types.StringNull()
+	}
+
+	return project, region
+}
+
+// importGatewayIdentity sets the project, region, namespace and gateway of the imported resource from either its
+// identity, or an import ID of {{project}}/{{namespace}}/{{gateway}} for global load balancers and
+// {{project}}/{{region}}/{{namespace}}/{{gateway}} for regional ones. Read fills in the rest.
+func (p *GKEGatewayProviderData) importGatewayIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity GatewayResourceIdentityModel
+
+	if req.ID != "" {
+		components := strings.Split(req.ID, "/")
+
+		switch len(components) {
+		case 3:
+			identity = GatewayResourceIdentityModel{
+				Gateway:   types.StringValue(components[2]),
+				Namespace: types.StringValue(components[1]),
+				Project:   types.StringValue(components[0]),
+				Region:    types.StringNull(),
+			}
+		case 4:
+			identity = GatewayResourceIdentityModel{
+				Gateway:   types.StringValue(components[3]),
+				Namespace: types.StringValue(components[2]),
+				Project:   types.StringValue(components[0]),
+				Region:    types.StringValue(components[1]),
+			}
+		default:
+			resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("The import ID must have the format {{project}}/{{namespace}}/{{gateway}} or {{project}}/{{region}}/{{namespace}}/{{gateway}}, got `%s`.", req.ID))
+			return
+		}
+
+		if slices.Contains(components, "") {
+			resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("The components of the import ID cannot be empty, got `%s`.", req.ID))
+			return
+		}
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gateway"), identity.Gateway)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), identity.Namespace)...)
+	project, region := p.unresolveScope(identity.Project, identity.Region)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &K8sResourceDescriptionFunction{}
+
+func NewK8sResourceDescriptionFunction() function.Function {
+	return &K8sResourceDescriptionFunction{}
+}
+
+// K8sResourceDescriptionFunction defines the function implementation.
+type K8sResourceDescriptionFunction struct{}
+
+func (f *K8sResourceDescriptionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Builds the JSON description GKE writes on the load balancer components of a Kubernetes gateway, such as `{\"k8sResource\":\"/namespaces/my-cool-app/gateways/my-gateway-name\"}`, so companion resources created alongside them can be described consistently. Forwarding rules described this way are taken for the ones of the gateway by the data sources of this provider.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Name:                "namespace",
+			},
+			function.StringParameter{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Name:                "gateway",
+			},
+		},
+		Return:  function.StringReturn{},
+		Summary: "Build the description GKE writes on the resources of a gateway",
+	}
+}
+
+func (f *K8sResourceDescriptionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_resource_description"
+}
+
+func (f *K8sResourceDescriptionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namespace, gateway string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &namespace, &gateway))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if !rfc1123LabelPattern.MatchString(namespace) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not the name of a Kubernetes namespace.", namespace))
+		return
+	}
+
+	if !rfc1123LabelPattern.MatchString(gateway) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%q is not the name of a Kubernetes gateway.", gateway))
+		return
+	}
+
+	description, err := json.Marshal(map[string]string{
+		"k8sResource": fmt.Sprintf("/namespaces/%s/gateways/%s", namespace, gateway),
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Error encoding the description: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(description)))
+}
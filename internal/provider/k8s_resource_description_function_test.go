@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccK8sResourceDescriptionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::k8s_resource_description("my-cool-app", "my-gateway-name")
+					}
+				`,
+				Check: resource.TestCheckOutput("test", `{"k8sResource":"/namespaces/my-cool-app/gateways/my-gateway-name"}`),
+			},
+			// invalid gateway
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::k8s_resource_description("my-cool-app", "my/gateway")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`is not the name of a Kubernetes gateway`),
+			},
+		},
+	})
+}
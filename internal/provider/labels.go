@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GKEGatewayProviderModelLabelMatching describes the label_matching block of the provider.
+type GKEGatewayProviderModelLabelMatching struct {
+	GatewayKey   types.String `tfsdk:"gateway_key"`
+	Labels       types.Map    `tfsdk:"labels"`
+	NamespaceKey types.String `tfsdk:"namespace_key"`
+}
+
+// labelMatching matches the forwarding rules to their Kubernetes gateway by the labels the controller sets on them,
+// which don't change format like the JSON descriptions can. A nil labelMatching, when the setting is off, matches
+// nothing.
+type labelMatching struct {
+	gatewayKey   string
+	labels       map[string]string
+	namespaceKey string
+}
+
+// match returns the namespace and name of the Kubernetes gateway the labels of the forwarding rule reference, reporting
+// whether they reference one.
+func (m *labelMatching) match(forwardingRule *computepb.ForwardingRule) (string, string, bool) {
+	if m == nil {
+		return "", "", false
+	}
+
+	labels := forwardingRule.GetLabels()
+
+	for key, value := range m.labels {
+		if labels[key] != value {
+			return "", "", false
+		}
+	}
+
+	namespace, gateway := labels[m.namespaceKey], labels[m.gatewayKey]
+
+	return namespace, gateway, namespace != "" && gateway != ""
+}
+
+// cacheKey identifies the settings deciding which rules match, so listings matched otherwise aren't read from the disk
+// cache.
+func (m *labelMatching) cacheKey() string {
+	if m == nil {
+		return ""
+	}
+
+	labels := []string{}
+	for _, key := range slices.Sorted(maps.Keys(m.labels)) {
+		labels = append(labels, fmt.Sprintf("%s=%s", key, m.labels[key]))
+	}
+
+	return fmt.Sprintf("%s/%s/%s", m.namespaceKey, m.gatewayKey, strings.Join(labels, ","))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestScanGatewayForwardingRulesLabels checks the forwarding rules listed by the compute API are matched to their
+// Kubernetes gateway by their labels when label_matching is set, before falling back to their description.
+func TestScanGatewayForwardingRulesLabels(t *testing.T) {
+	tests := map[string]struct {
+		description string
+		labels      map[string]string
+		expected    *gatewayForwardingRule
+	}{
+		"labels": {
+			labels:   map[string]string{"gateway": "my-gateway-name", "managed-by": "gke", "namespace": "my-cool-app"},
+			expected: &gatewayForwardingRule{format: "labels", gateway: "my-gateway-name", kind: "gateway", namespace: "my-cool-app"},
+		},
+		"labels over description": {
+			description: `{"k8sResource":"/namespaces/my-cool-app/gateways/my-other-gateway"}`,
+			labels:      map[string]string{"gateway": "my-gateway-name", "managed-by": "gke", "namespace": "my-cool-app"},
+			expected:    &gatewayForwardingRule{format: "labels", gateway: "my-gateway-name", kind: "gateway", namespace: "my-cool-app"},
+		},
+		"missing required label": {
+			description: `{"k8sResource":"/namespaces/my-cool-app/gateways/my-other-gateway"}`,
+			labels:      map[string]string{"gateway": "my-gateway-name", "namespace": "my-cool-app"},
+			expected:    &gatewayForwardingRule{format: "k8s-resource-path", gateway: "my-other-gateway", kind: "gateway", namespace: "my-cool-app"},
+		},
+		"different required label": {
+			labels: map[string]string{"gateway": "my-gateway-name", "managed-by": "terraform", "namespace": "my-cool-app"},
+		},
+		"missing gateway label": {
+			labels: map[string]string{"managed-by": "gke", "namespace": "my-cool-app"},
+		},
+		"empty namespace label": {
+			labels: map[string]string{"gateway": "my-gateway-name", "managed-by": "gke", "namespace": ""},
+		},
+		"no labels": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			forwardingRule := &computepb.ForwardingRule{
+				Labels: test.labels,
+				Name:   proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			}
+
+			if test.description != "" {
+				forwardingRule.Description = proto.String(test.description)
+			}
+
+			server := testAccComputeServer(t, map[string]proto.Message{
+				"projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd": forwardingRule,
+			})
+
+			p := testComputeProviderData(t, server, map[string]tftypes.Value{
+				"label_matching": testProviderBlockValue(t, "label_matching", map[string]tftypes.Value{
+					"gateway_key": tftypes.NewValue(tftypes.String, "gateway"),
+					"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"managed-by": tftypes.NewValue(tftypes.String, "gke"),
+					}),
+					"namespace_key": tftypes.NewValue(tftypes.String, "namespace"),
+				}),
+			})
+
+			gatewayForwardingRules, diags := p.scanGatewayForwardingRules(context.Background(), "my-gcp-project", types.StringNull())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if test.expected == nil {
+				if len(gatewayForwardingRules) > 0 {
+					t.Errorf("expected no gateway forwarding rule, got %+v", gatewayForwardingRules)
+				}
+
+				return
+			}
+
+			if len(gatewayForwardingRules) != 1 {
+				t.Fatalf("expected a gateway forwarding rule, got %+v", gatewayForwardingRules)
+			}
+
+			got := gatewayForwardingRules[0]
+			got.forwardingRule = nil
+
+			if got != *test.expected {
+				t.Errorf("expected %+v, got %+v", *test.expected, got)
+			}
+		})
+	}
+}
+
+// TestLabelMatchingCacheKey checks the cache key changes with every setting deciding which rules match, regardless of
+// the order of the labels.
+func TestLabelMatchingCacheKey(t *testing.T) {
+	matching := &labelMatching{
+		gatewayKey:   "gateway",
+		labels:       map[string]string{"managed-by": "gke", "env": "prod"},
+		namespaceKey: "namespace",
+	}
+
+	if got, expected := matching.cacheKey(), "namespace/gateway/env=prod,managed-by=gke"; got != expected {
+		t.Errorf("expected cache key %q, got %q", expected, got)
+	}
+
+	if got := (*labelMatching)(nil).cacheKey(); got != "" {
+		t.Errorf("expected an empty cache key without label matching, got %q", got)
+	}
+}
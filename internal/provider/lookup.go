@@ -0,0 +1,1214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/googleapis/gax-go/v2/callctx"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/iterator"
+)
+
+type backendServiceDescription struct {
+	ServiceName *string `json:"kubernetes.io/service-name"`
+	ServicePort *string `json:"kubernetes.io/service-port"`
+}
+
+// resolveScope determines the project and region to search, preferring the values set on the data source over the
+// provider. A null region means the load balancer is presumed to be global.
+func (p *GKEGatewayProviderData) resolveScope(project types.String, region types.String) (string, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// project can be set at the provider or data source level, the latter taking precedence, but ultimately required.
+	if p.project.IsNull() && project.IsNull() {
+		diags.AddError("Missing project", "The project field must be set on either the provider or data source.")
+		return "", region, diags
+	}
+
+	if project.IsUnknown() {
+		diags.AddError("Unknown project", "The project field on the data source cannot be set to an unknown value")
+		return "", region, diags
+	}
+
+	resolvedProject := p.project.ValueString()
+	if !project.IsNull() {
+		resolvedProject = project.ValueString()
+	}
+
+	// region can be set at the provider, data source level, or not at all.
+	if region.IsUnknown() {
+		diags.AddError("Unknown region", "The region field on the data source cannot be set to an unknown value")
+		return "", region, diags
+	}
+
+	resolvedRegion := p.region
+	if !region.IsNull() {
+		resolvedRegion = region
+	}
+
+	return resolvedProject, resolvedRegion, diags
+}
+
+// gatewayForwardingRule is a forwarding rule along with the Kubernetes gateway its description references, and the
+// format of the description, or labels when matched by its labels. The rules of the legacy GKE Ingress controller are
+// listed too, with the Ingress as gateway and a kind of ingress.
+type gatewayForwardingRule struct {
+	format         string
+	forwardingRule *computepb.ForwardingRule
+	gateway        string
+	kind           string
+	namespace      string
+	uid            string
+}
+
+// listGatewayForwardingRules lists the forwarding rules in scope whose description references a Kubernetes gateway.
+// The listing is cached, so the data sources of a plan reading the same scope list it only once.
+func (p *GKEGatewayProviderData) listGatewayForwardingRules(ctx context.Context, project string, region types.String) ([]gatewayForwardingRule, diag.Diagnostics) {
+	start := time.Now()
+
+	forwardingRules, diags, loaded := p.forwardingRulesCache.load(forwardingRulesCacheKey(project, region), false, func() ([]gatewayForwardingRule, diag.Diagnostics) {
+		forwardingRules, diags, _ := p.readGatewayForwardingRules(ctx, project, region, false)
+		return forwardingRules, diags
+	})
+
+	p.metrics.recordCache("forwarding_rule_memory_cache", start, !loaded, len(forwardingRules))
+
+	if forwardingRules == nil {
+		return nil, diags
+	}
+
+	gatewayForwardingRules := make([]gatewayForwardingRule, 0, len(forwardingRules))
+	for _, gfr := range forwardingRules {
+		if gfr.kind == "gateway" {
+			gatewayForwardingRules = append(gatewayForwardingRules, gfr)
+		}
+	}
+
+	return gatewayForwardingRules, diags
+}
+
+// readGatewayForwardingRules returns the gateway forwarding rules in scope from the disk cache, scanning them when they
+// aren't cached there or a refresh is asked for. The returned boolean reports whether the rules were scanned.
+func (p *GKEGatewayProviderData) readGatewayForwardingRules(ctx context.Context, project string, region types.String, refresh bool) ([]gatewayForwardingRule, diag.Diagnostics, bool) {
+	// The description key and label matching are part of the key, as they decide which rules are kept. The fields are
+	// too, so forwarding rules cached before more fields were read aren't used.
+	key := fmt.Sprintf("forwarding_rules/%s/%s/%s/%s/%s", project, region.ValueString(), p.descriptionKey, p.labelMatching.cacheKey(), forwardingRuleListFields)
+
+	start := time.Now()
+
+	cached := &computepb.ForwardingRuleList{}
+	hit := !refresh && p.diskCache.get(key, cached)
+
+	// Refreshes skip the cache on purpose, so only the lookups of an enabled cache count towards its hit rate.
+	if !refresh && p.diskCache != nil {
+		p.metrics.recordCache("forwarding_rule_disk_cache", start, hit, len(cached.GetItems()))
+	}
+
+	if hit {
+		gatewayForwardingRules := make([]gatewayForwardingRule, 0, len(cached.GetItems()))
+
+		for _, forwardingRule := range cached.GetItems() {
+			if gfr, ok, _ := p.parseGatewayForwardingRule(forwardingRule); ok {
+				gatewayForwardingRules = append(gatewayForwardingRules, gfr)
+			}
+		}
+
+		return gatewayForwardingRules, nil, false
+	}
+
+	gatewayForwardingRules, diags := p.scanGatewayForwardingRules(ctx, project, region)
+
+	// Projects that don't exist yet and partial listings aren't cached, so the missing rules are found on the next run.
+	if len(diags) == 0 && gatewayForwardingRules != nil {
+		list := &computepb.ForwardingRuleList{}
+		for _, gfr := range gatewayForwardingRules {
+			list.Items = append(list.Items, gfr.forwardingRule)
+		}
+
+		p.diskCache.put(key, list)
+	}
+
+	return gatewayForwardingRules, diags, true
+}
+
+// forwardingRuleListFields are the fields of the forwarding rule listings the lookups read. They are requested as a
+// partial response, so the listings of projects with many rules stay small. A field read from the listed rules must be
+// added here too, which TestFieldMasks checks.
+const forwardingRuleListFields = "items(IPAddress,description,labels,loadBalancingScheme,name,network,portRange,selfLink,subnetwork,target),nextPageToken,warning"
+
+// scanGatewayForwardingRules lists the forwarding rules in scope whose description references a Kubernetes gateway,
+// bypassing the cache. A project that doesn't exist yet yields no rules rather than an error.
+func (p *GKEGatewayProviderData) scanGatewayForwardingRules(ctx context.Context, project string, region types.String) ([]gatewayForwardingRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	start := time.Now()
+
+	// Unless labels are matched too, the API only returns the matching rules, so projects with thousands of rules
+	// aren't scanned in full.
+	filter := p.gatewayForwardingRulesFilter()
+
+	// Loop over the forwarding rules.
+	ctx = withFields(ctx, forwardingRuleListFields)
+
+	var forwardingRulesIterator *compute.ForwardingRuleIterator
+	if region.IsNull() {
+		forwardingRulesIterator = p.globalForwardingRulesClient.List(ctx, &computepb.ListGlobalForwardingRulesRequest{
+			Filter:               filter,
+			MaxResults:           p.listMaxResults(),
+			Project:              project,
+			ReturnPartialSuccess: p.listReturnPartialSuccess(),
+		})
+	} else {
+		forwardingRulesIterator = p.forwardingRulesClient.List(ctx, &computepb.ListForwardingRulesRequest{
+			Filter:               filter,
+			MaxResults:           p.listMaxResults(),
+			Project:              project,
+			Region:               region.ValueString(),
+			ReturnPartialSuccess: p.listReturnPartialSuccess(),
+		})
+	}
+
+	// A partial listing comes with a warning on the pages missing rules, each of which is reported once.
+	var lastPage any
+
+	gatewayForwardingRules := make([]gatewayForwardingRule, 0)
+
+	for {
+		forwardingRule, err := forwardingRulesIterator.Next()
+
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			// Ignore 404 errors for projects that don't exist yet.
+			if e, ok := err.(*apierror.APIError); ok && e.HTTPCode() == 404 {
+				return nil, diags
+			}
+
+			diags.AddError("Unable to iterate over forwarding rules", fmt.Sprintf("Error calling Google API: %+v", err))
+			return nil, diags
+		}
+
+		if page, ok := forwardingRulesIterator.Response.(*computepb.ForwardingRuleList); ok && page != lastPage {
+			lastPage = page
+
+			if warning := page.GetWarning(); warning != nil {
+				diags.AddWarning("Partial forwarding rule listing", fmt.Sprintf("The forwarding rules in scope may be incomplete, the API returned %s: %s", warning.GetCode(), warning.GetMessage()))
+			}
+		}
+
+		gfr, ok, err := p.parseGatewayForwardingRule(forwardingRule)
+		if err != nil {
+			diags.Append(unrecognizedDescriptionWarning(forwardingRule, err))
+		} else if ok {
+			gatewayForwardingRules = append(gatewayForwardingRules, gfr)
+		}
+	}
+
+	p.metrics.record("forwarding_rule_scan", start, len(gatewayForwardingRules))
+
+	return gatewayForwardingRules, diags
+}
+
+// parseGatewayForwardingRule pairs the forwarding rule with the Kubernetes gateway its labels or description reference,
+// reporting whether it references one. The error reports descriptions in a layout no known format recognizes.
+func (p *GKEGatewayProviderData) parseGatewayForwardingRule(forwardingRule *computepb.ForwardingRule) (gatewayForwardingRule, bool, error) {
+	if namespace, gateway, ok := p.labelMatching.match(forwardingRule); ok {
+		return gatewayForwardingRule{
+			format:         "labels",
+			forwardingRule: forwardingRule,
+			gateway:        gateway,
+			kind:           "gateway",
+			namespace:      namespace,
+		}, true, nil
+	}
+
+	description, err := parseGatewayDescription(forwardingRule.GetDescription(), p.descriptionKey)
+	if err != nil || description == nil {
+		return gatewayForwardingRule{}, false, err
+	}
+
+	return gatewayForwardingRule{
+		format:         description.format,
+		forwardingRule: forwardingRule,
+		gateway:        description.gateway,
+		kind:           description.kind,
+		namespace:      description.namespace,
+		uid:            description.uid,
+	}, true, nil
+}
+
+// unrecognizedDescriptionWarning warns about a forwarding rule whose description may reference a Kubernetes gateway in
+// a layout the provider doesn't know, so controller upgrades don't silently break the lookups.
+func unrecognizedDescriptionWarning(forwardingRule *computepb.ForwardingRule, err error) diag.Diagnostic {
+	return diag.NewWarningDiagnostic("Unrecognized forwarding rule description", fmt.Sprintf("The description of the %s forwarding rule may reference a Kubernetes gateway, but %s, so the rule is ignored. The provider may need an upgrade to support the controller that created it: %s", forwardingRule.GetName(), err, forwardingRule.GetDescription()))
+}
+
+// listMaxResults returns the page size of the forwarding rule listings, nil leaving it to the API.
+func (p *GKEGatewayProviderData) listMaxResults() *uint32 {
+	if p.maxResults == 0 {
+		return nil
+	}
+
+	return &p.maxResults
+}
+
+// listReturnPartialSuccess returns whether the forwarding rule listings tolerate the parts of the scope that are
+// unavailable, nil leaving it to the API.
+func (p *GKEGatewayProviderData) listReturnPartialSuccess() *bool {
+	if !p.returnPartialSuccess {
+		return nil
+	}
+
+	return &p.returnPartialSuccess
+}
+
+// gatewayForwardingRulesFilter returns the list filter of the gateway forwarding rules, or nil when they are matched by
+// their labels too, as the compute API can't filter on labels and descriptions with a single filter.
+func (p *GKEGatewayProviderData) gatewayForwardingRulesFilter() *string {
+	if p.labelMatching != nil {
+		return nil
+	}
+
+	filter := descriptionForwardingRulesFilter(p.descriptionKey)
+
+	return &filter
+}
+
+// findGatewayForwardingRules returns the forwarding rules in scope whose description references the given Kubernetes
+// gateway.
+func (p *GKEGatewayProviderData) findGatewayForwardingRules(ctx context.Context, project string, region types.String, namespace string, gateway string) ([]*computepb.ForwardingRule, diag.Diagnostics) {
+	return p.findKindForwardingRules(ctx, project, region, "gateway", namespace, gateway, "")
+}
+
+// findKindForwardingRules returns the forwarding rules in scope whose description references the given Kubernetes
+// resource, of a kind of either gateway or ingress. A non-empty UID only matches the rules of that incarnation of the
+// resource.
+func (p *GKEGatewayProviderData) findKindForwardingRules(ctx context.Context, project string, region types.String, kind string, namespace string, gateway string, uid string) ([]*computepb.ForwardingRule, diag.Diagnostics) {
+	key := forwardingRulesCacheKey(project, region)
+
+	// Whether the listing was scanned by this lookup, rather than read from either cache.
+	scanned := false
+
+	start := time.Now()
+
+	gatewayForwardingRules, diags, loaded := p.forwardingRulesCache.load(key, false, func() ([]gatewayForwardingRule, diag.Diagnostics) {
+		gatewayForwardingRules, diags, fresh := p.readGatewayForwardingRules(ctx, project, region, false)
+		scanned = fresh
+
+		return gatewayForwardingRules, diags
+	})
+
+	p.metrics.recordCache("forwarding_rule_memory_cache", start, !loaded, len(gatewayForwardingRules))
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	matchingForwardingRules := matchGatewayForwardingRules(gatewayForwardingRules, kind, namespace, gateway, uid)
+
+	// GKE may have created the load balancer during an apply after the scope was cached, in memory or on disk, so a
+	// gateway missing from a cached listing is looked up again.
+	if len(matchingForwardingRules) == 0 && !scanned {
+		gatewayForwardingRules, diags, _ = p.forwardingRulesCache.load(key, true, func() ([]gatewayForwardingRule, diag.Diagnostics) {
+			gatewayForwardingRules, diags, _ := p.readGatewayForwardingRules(ctx, project, region, true)
+			return gatewayForwardingRules, diags
+		})
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		matchingForwardingRules = matchGatewayForwardingRules(gatewayForwardingRules, kind, namespace, gateway, uid)
+	}
+
+	if gatewayForwardingRules == nil {
+		return nil, diags
+	}
+
+	return matchingForwardingRules, diags
+}
+
+// findGatewayForwardingRuleRegions returns the scopes where forwarding rules reference the given Kubernetes gateway, or
+// Ingress with a kind of ingress, listing every region and the global scope in a single aggregated call, with a null
+// region standing for the global scope. The complete listings are kept in the in-memory cache, so looking the gateway
+// up in its scopes lists nothing.
+func (p *GKEGatewayProviderData) findGatewayForwardingRuleRegions(ctx context.Context, project string, kind string, namespace string, gateway string, uid string) ([]types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	start := time.Now()
+
+	pairsIterator := p.forwardingRulesClient.AggregatedList(ctx, &computepb.AggregatedListForwardingRulesRequest{
+		Filter:               p.gatewayForwardingRulesFilter(),
+		MaxResults:           p.listMaxResults(),
+		Project:              project,
+		ReturnPartialSuccess: p.listReturnPartialSuccess(),
+	})
+
+	// A scope can span several pages, so the listings are only complete once every page is read.
+	scopes := []string{}
+	scopeRules := map[string][]gatewayForwardingRule{}
+	partialScopes := map[string]bool{}
+	count := 0
+
+	for {
+		pair, err := pairsIterator.Next()
+
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			// Ignore 404 errors for projects that don't exist yet.
+			if e, ok := err.(*apierror.APIError); ok && e.HTTPCode() == 404 {
+				return nil, diags
+			}
+
+			diags.AddError("Unable to iterate over forwarding rules", fmt.Sprintf("Error calling Google API: %+v", err))
+			return nil, diags
+		}
+
+		if _, ok := scopeRules[pair.Key]; !ok {
+			scopes = append(scopes, pair.Key)
+			scopeRules[pair.Key] = []gatewayForwardingRule{}
+		}
+
+		// Scopes without any matching rule come with a warning saying so, which isn't worth reporting.
+		if warning := pair.Value.GetWarning(); warning != nil && warning.GetCode() != computepb.Warning_NO_RESULTS_ON_PAGE.String() {
+			partialScopes[pair.Key] = true
+			diags.AddWarning("Partial forwarding rule listing", fmt.Sprintf("The forwarding rules in %s may be incomplete, the API returned %s: %s", pair.Key, warning.GetCode(), warning.GetMessage()))
+		}
+
+		for _, forwardingRule := range pair.Value.GetForwardingRules() {
+			gfr, ok, err := p.parseGatewayForwardingRule(forwardingRule)
+			if err != nil {
+				diags.Append(unrecognizedDescriptionWarning(forwardingRule, err))
+			} else if ok {
+				scopeRules[pair.Key] = append(scopeRules[pair.Key], gfr)
+				count++
+			}
+		}
+	}
+
+	p.metrics.record("forwarding_rule_aggregated_scan", start, count)
+
+	regions := []types.String{}
+
+	for _, scope := range scopes {
+		region := types.StringNull()
+		if name, ok := strings.CutPrefix(scope, "regions/"); ok {
+			region = types.StringValue(name)
+		} else if scope != "global" {
+			continue
+		}
+
+		rules := scopeRules[scope]
+
+		if !partialScopes[scope] {
+			p.forwardingRulesCache.load(forwardingRulesCacheKey(project, region), true, func() ([]gatewayForwardingRule, diag.Diagnostics) {
+				return rules, nil
+			})
+		}
+
+		if len(matchGatewayForwardingRules(rules, kind, namespace, gateway, uid)) > 0 {
+			regions = append(regions, region)
+		}
+	}
+
+	return regions, diags
+}
+
+// matchGatewayForwardingRules keeps the forwarding rules of the given Kubernetes gateway, or Ingress with a kind of
+// ingress. With a UID, rules lingering from an earlier resource of the same name, or not embedding a UID, are dropped.
+func matchGatewayForwardingRules(gatewayForwardingRules []gatewayForwardingRule, kind string, namespace string, gateway string, uid string) []*computepb.ForwardingRule {
+	matchingForwardingRules := make([]*computepb.ForwardingRule, 0)
+
+	for _, gfr := range gatewayForwardingRules {
+		if gfr.kind == kind && gfr.namespace == namespace && gfr.gateway == gateway && (uid == "" || gfr.uid == uid) {
+			matchingForwardingRules = append(matchingForwardingRules, gfr.forwardingRule)
+		}
+	}
+
+	return matchingForwardingRules
+}
+
+// filterForwardingRulesByNetwork keeps the forwarding rules in the given VPC network and subnetwork, each of which can
+// be a name, self_link or ID, or null to match any.
+func filterForwardingRulesByNetwork(forwardingRules []*computepb.ForwardingRule, network types.String, subnetwork types.String) []*computepb.ForwardingRule {
+	matchingForwardingRules := make([]*computepb.ForwardingRule, 0)
+
+	for _, forwardingRule := range forwardingRules {
+		if !network.IsNull() && !matchesComputeResource(network.ValueString(), forwardingRule.GetNetwork()) {
+			continue
+		}
+
+		if !subnetwork.IsNull() && !matchesComputeResource(subnetwork.ValueString(), forwardingRule.GetSubnetwork()) {
+			continue
+		}
+
+		matchingForwardingRules = append(matchingForwardingRules, forwardingRule)
+	}
+
+	return matchingForwardingRules
+}
+
+// matchesComputeResource reports whether the name, self_link or ID references the compute resource with the given
+// self_link.
+func matchesComputeResource(value string, selfLink string) bool {
+	if strings.Contains(value, "/") {
+		return sameComputeResource(value, selfLink)
+	}
+
+	selfLinkComponents := strings.Split(selfLink, "/")
+
+	return selfLinkComponents[len(selfLinkComponents)-1] == value
+}
+
+// selfLinkProject returns the project the self_link names, or the given project when it is a bare name. With Shared
+// VPC, the components of a load balancer can be in the host project rather than the project of the forwarding rule.
+func selfLinkProject(selfLink string, project string) string {
+	components := strings.Split(selfLink, "/")
+
+	for i := 0; i+1 < len(components); i++ {
+		if components[i] == "projects" {
+			return components[i+1]
+		}
+	}
+
+	return project
+}
+
+// findGatewayForwardingRule returns the single forwarding rule created for the given Kubernetes gateway, or nil when
+// there is none.
+func (p *GKEGatewayProviderData) findGatewayForwardingRule(ctx context.Context, project string, region types.String, namespace string, gateway string) (*computepb.ForwardingRule, diag.Diagnostics) {
+	matchingForwardingRules, diags := p.findGatewayForwardingRules(ctx, project, region, namespace, gateway)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	matchingForwardingRules, _, redirectDiags := p.splitRedirectForwardingRules(ctx, project, region, matchingForwardingRules)
+	diags.Append(redirectDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	forwardingRule, singleDiags := singleForwardingRule(matchingForwardingRules)
+	diags.Append(singleDiags...)
+
+	return forwardingRule, diags
+}
+
+// splitRedirectForwardingRules sets apart the forwarding rules only redirecting HTTP to HTTPS, which GKE creates next to
+// the HTTPS ones for the gateways with redirect listeners. They are only looked for among several forwarding rules, and
+// kept when every rule redirects, so lookups of a single forwarding rule make no extra calls.
+func (p *GKEGatewayProviderData) splitRedirectForwardingRules(ctx context.Context, project string, region types.String, forwardingRules []*computepb.ForwardingRule) ([]*computepb.ForwardingRule, []*computepb.ForwardingRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(forwardingRules) < 2 {
+		return forwardingRules, nil, diags
+	}
+
+	servingForwardingRules := []*computepb.ForwardingRule{}
+	redirectForwardingRules := []*computepb.ForwardingRule{}
+
+	for _, forwardingRule := range forwardingRules {
+		if !strings.Contains(forwardingRule.GetTarget(), "/targetHttpProxies/") {
+			servingForwardingRules = append(servingForwardingRules, forwardingRule)
+			continue
+		}
+
+		proxy, proxyDiags := p.getTargetProxy(ctx, project, region, forwardingRule)
+		diags.Append(proxyDiags...)
+
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		urlMap, urlMapDiags := p.getUrlMapByPath(ctx, project, region, proxy.urlMap)
+		diags.Append(urlMapDiags...)
+
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		if isRedirectUrlMap(urlMap) {
+			redirectForwardingRules = append(redirectForwardingRules, forwardingRule)
+		} else {
+			servingForwardingRules = append(servingForwardingRules, forwardingRule)
+		}
+	}
+
+	if len(servingForwardingRules) == 0 {
+		return forwardingRules, nil, diags
+	}
+
+	return servingForwardingRules, redirectForwardingRules, diags
+}
+
+// isRedirectUrlMap reports whether the URL map redirects every request, without routing any to a backend.
+func isRedirectUrlMap(urlMap *computepb.UrlMap) bool {
+	return urlMap.DefaultUrlRedirect != nil && urlMap.DefaultService == nil && urlMap.DefaultRouteAction == nil && len(urlMap.PathMatchers) == 0
+}
+
+// singleForwardingRule returns the only forwarding rule of the list, or nil when it is empty.
+func singleForwardingRule(matchingForwardingRules []*computepb.ForwardingRule) (*computepb.ForwardingRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(matchingForwardingRules) == 0 {
+		return nil, diags
+	} else if len(matchingForwardingRules) > 1 {
+		debugMessage := "The following forwarding rules matched:\n\n"
+		for _, rule := range matchingForwardingRules {
+			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, rule.GetName())
+		}
+
+		diags.AddError("Multiple matching forwarding rules found", debugMessage)
+		return nil, diags
+	}
+
+	return matchingForwardingRules[0], diags
+}
+
+// getTargetGrpcProxy fetches the target gRPC proxy with the given name. gRPC proxies only exist globally.
+func (p *GKEGatewayProviderData) getTargetGrpcProxy(ctx context.Context, project string, region types.String, name string) (*computepb.TargetGrpcProxy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !region.IsNull() {
+		diags.AddError(fmt.Sprintf("Error looking up gRPC target proxy %s", name), fmt.Sprintf("gRPC target proxies are global but the %s region was requested.", region.ValueString()))
+		return nil, diags
+	}
+
+	proxy, err := p.targetGrpcProxiesClient.Get(ctx, &computepb.GetTargetGrpcProxyRequest{
+		Project:         project,
+		TargetGrpcProxy: name,
+	})
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up gRPC target proxy %s", name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return proxy, diags
+}
+
+// getTargetHttpProxy fetches the target HTTP proxy with the given name.
+func (p *GKEGatewayProviderData) getTargetHttpProxy(ctx context.Context, project string, region types.String, name string) (*computepb.TargetHttpProxy, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		err   error
+		proxy *computepb.TargetHttpProxy
+	)
+
+	if region.IsNull() {
+		proxy, err = p.targetHttpProxiesClient.Get(ctx, &computepb.GetTargetHttpProxyRequest{
+			Project:         project,
+			TargetHttpProxy: name,
+		})
+	} else {
+		proxy, err = p.regionTargetHttpProxiesClient.Get(ctx, &computepb.GetRegionTargetHttpProxyRequest{
+			Project:         project,
+			Region:          region.ValueString(),
+			TargetHttpProxy: name,
+		})
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up HTTP target proxy %s", name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return proxy, diags
+}
+
+// getTargetHttpsProxy fetches the target HTTPS proxy with the given name.
+func (p *GKEGatewayProviderData) getTargetHttpsProxy(ctx context.Context, project string, region types.String, name string) (*computepb.TargetHttpsProxy, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		err   error
+		proxy *computepb.TargetHttpsProxy
+	)
+
+	if region.IsNull() {
+		proxy, err = p.targetHttpsProxiesClient.Get(ctx, &computepb.GetTargetHttpsProxyRequest{
+			Project:          project,
+			TargetHttpsProxy: name,
+		})
+	} else {
+		proxy, err = p.regionTargetHttpsProxiesClient.Get(ctx, &computepb.GetRegionTargetHttpsProxyRequest{
+			Project:          project,
+			Region:           region.ValueString(),
+			TargetHttpsProxy: name,
+		})
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up HTTPS target proxy %s", name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return proxy, diags
+}
+
+// targetProxy is the target of a forwarding rule, which references either a URL map or, for L4 proxies, a backend
+// service directly.
+type targetProxy struct {
+	kind     string
+	name     string
+	selfLink string
+	service  string
+	urlMap   string
+}
+
+// getTargetSslProxy fetches the target SSL proxy with the given name. SSL proxies only exist globally.
+func (p *GKEGatewayProviderData) getTargetSslProxy(ctx context.Context, project string, region types.String, name string) (*computepb.TargetSslProxy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !region.IsNull() {
+		diags.AddError(fmt.Sprintf("Error looking up SSL target proxy %s", name), fmt.Sprintf("SSL target proxies are global but the %s region was requested.", region.ValueString()))
+		return nil, diags
+	}
+
+	proxy, err := p.targetSslProxiesClient.Get(ctx, &computepb.GetTargetSslProxyRequest{
+		Project:        project,
+		TargetSslProxy: name,
+	})
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up SSL target proxy %s", name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return proxy, diags
+}
+
+// getTargetTcpProxy fetches the target TCP proxy with the given name.
+func (p *GKEGatewayProviderData) getTargetTcpProxy(ctx context.Context, project string, region types.String, name string) (*computepb.TargetTcpProxy, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		err   error
+		proxy *computepb.TargetTcpProxy
+	)
+
+	if region.IsNull() {
+		proxy, err = p.targetTcpProxiesClient.Get(ctx, &computepb.GetTargetTcpProxyRequest{
+			Project:        project,
+			TargetTcpProxy: name,
+		})
+	} else {
+		proxy, err = p.regionTargetTcpProxiesClient.Get(ctx, &computepb.GetRegionTargetTcpProxyRequest{
+			Project:        project,
+			Region:         region.ValueString(),
+			TargetTcpProxy: name,
+		})
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up TCP target proxy %s", name), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return proxy, diags
+}
+
+// getTargetProxy looks up the target of the forwarding rule, in the project its self_link names.
+func (p *GKEGatewayProviderData) getTargetProxy(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule) (*targetProxy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	project = selfLinkProject(forwardingRule.GetTarget(), project)
+	targetComponents := strings.Split(forwardingRule.GetTarget(), "/")
+
+	switch targetComponents[len(targetComponents)-2] {
+	case "targetGrpcProxies":
+		proxy, proxyDiags := p.getTargetGrpcProxy(ctx, project, region, targetComponents[len(targetComponents)-1])
+		diags.Append(proxyDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &targetProxy{
+			kind:     targetComponents[len(targetComponents)-2],
+			name:     proxy.GetName(),
+			selfLink: proxy.GetSelfLink(),
+			urlMap:   proxy.GetUrlMap(),
+		}, diags
+	case "targetHttpProxies":
+		proxy, proxyDiags := p.getTargetHttpProxy(ctx, project, region, targetComponents[len(targetComponents)-1])
+		diags.Append(proxyDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &targetProxy{
+			kind:     targetComponents[len(targetComponents)-2],
+			name:     proxy.GetName(),
+			selfLink: proxy.GetSelfLink(),
+			urlMap:   proxy.GetUrlMap(),
+		}, diags
+	case "targetHttpsProxies":
+		proxy, proxyDiags := p.getTargetHttpsProxy(ctx, project, region, targetComponents[len(targetComponents)-1])
+		diags.Append(proxyDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &targetProxy{
+			kind:     targetComponents[len(targetComponents)-2],
+			name:     proxy.GetName(),
+			selfLink: proxy.GetSelfLink(),
+			urlMap:   proxy.GetUrlMap(),
+		}, diags
+	case "targetSslProxies":
+		proxy, proxyDiags := p.getTargetSslProxy(ctx, project, region, targetComponents[len(targetComponents)-1])
+		diags.Append(proxyDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &targetProxy{
+			kind:     targetComponents[len(targetComponents)-2],
+			name:     proxy.GetName(),
+			selfLink: proxy.GetSelfLink(),
+			service:  proxy.GetService(),
+		}, diags
+	case "targetTcpProxies":
+		proxy, proxyDiags := p.getTargetTcpProxy(ctx, project, region, targetComponents[len(targetComponents)-1])
+		diags.Append(proxyDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &targetProxy{
+			kind:     targetComponents[len(targetComponents)-2],
+			name:     proxy.GetName(),
+			selfLink: proxy.GetSelfLink(),
+			service:  proxy.GetService(),
+		}, diags
+	default:
+		diags.AddError("Unsupported target type for forwarding rule", fmt.Sprintf("The %s forwarding rule has a target with a type of %s which is currently unsupported by this provider.", forwardingRule.GetName(), targetComponents[len(targetComponents)-2]))
+		return nil, diags
+	}
+}
+
+// urlMapFields are the fields of the URL maps the read-only lookups follow to the backends. URL maps with thousands of
+// host rules and path rules are mostly made of fields the provider never reads. A field read from the cached URL maps
+// must be added here too, which TestFieldMasks checks.
+const urlMapFields = "defaultRouteAction(faultInjectionPolicy,weightedBackendServices(backendService)),defaultService,defaultUrlRedirect," +
+	"hostRules(hosts,pathMatcher),name," +
+	"pathMatchers(defaultRouteAction(faultInjectionPolicy,weightedBackendServices(backendService)),defaultService,name," +
+	"pathRules(paths,routeAction(faultInjectionPolicy,weightedBackendServices(backendService)),service)," +
+	"routeRules(matchRules(fullPathMatch,ignoreCase,pathTemplateMatch,prefixMatch,regexMatch),priority," +
+	"routeAction(faultInjectionPolicy,maxStreamDuration,weightedBackendServices(backendService)),service))," +
+	"selfLink"
+
+// getUrlMapByPath returns the URL map referenced by the given path, from the disk cache when it is cached there.
+func (p *GKEGatewayProviderData) getUrlMapByPath(ctx context.Context, project string, region types.String, path string) (*computepb.UrlMap, diag.Diagnostics) {
+	// The fields are part of the key, so URL maps cached before more fields were read aren't used.
+	key := fmt.Sprintf("url_maps/%s/%s/%s/%s", project, region.ValueString(), path, urlMapFields)
+
+	start := time.Now()
+
+	cached := &computepb.UrlMap{}
+	hit := p.diskCache.get(key, cached)
+
+	if p.diskCache != nil {
+		count := 0
+		if hit {
+			count = 1
+		}
+
+		p.metrics.recordCache("url_map_disk_cache", start, hit, count)
+	}
+
+	if hit {
+		return cached, nil
+	}
+
+	urlMap, diags := p.fetchUrlMapByPath(withFields(ctx, urlMapFields), project, region, path)
+
+	if !diags.HasError() {
+		p.diskCache.put(key, urlMap)
+	}
+
+	return urlMap, diags
+}
+
+// fetchUrlMapByPath fetches the URL map referenced by the given path, in the project it names, bypassing the disk cache.
+func (p *GKEGatewayProviderData) fetchUrlMapByPath(ctx context.Context, project string, region types.String, path string) (*computepb.UrlMap, diag.Diagnostics) {
+	var (
+		diags  diag.Diagnostics
+		err    error
+		urlMap *computepb.UrlMap
+	)
+
+	project = selfLinkProject(path, project)
+	urlMapComponents := strings.Split(path, "/")
+
+	if region.IsNull() {
+		urlMap, err = p.urlMapsClient.Get(ctx, &computepb.GetUrlMapRequest{
+			Project: project,
+			UrlMap:  urlMapComponents[len(urlMapComponents)-1],
+		})
+	} else {
+		urlMap, err = p.regionUrlMapsClient.Get(ctx, &computepb.GetRegionUrlMapRequest{
+			Project: project,
+			Region:  region.ValueString(),
+			UrlMap:  urlMapComponents[len(urlMapComponents)-1],
+		})
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up URL map %s", urlMapComponents[len(urlMapComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return urlMap, diags
+}
+
+// getUrlMap follows the target of the forwarding rule to the URL map it serves. The URL map is always fetched in full,
+// as updating it sends it back whole along with its current fingerprint.
+func (p *GKEGatewayProviderData) getUrlMap(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule) (*computepb.UrlMap, diag.Diagnostics) {
+	proxy, diags := p.getTargetProxy(ctx, project, region, forwardingRule)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if proxy.urlMap == "" {
+		diags.AddError("No URL map found", fmt.Sprintf("The %s forwarding rule has a target with a type of %s which doesn't use a URL map.", forwardingRule.GetName(), proxy.kind))
+		return nil, diags
+	}
+
+	urlMap, urlMapDiags := p.fetchUrlMapByPath(ctx, project, region, proxy.urlMap)
+	diags.Append(urlMapDiags...)
+
+	return urlMap, diags
+}
+
+// urlMapServicePaths parses the URL map to determine the eligible services, which can be backend services or backend
+// buckets.
+func urlMapServicePaths(urlMap *computepb.UrlMap) []*string {
+	servicePaths := []*string{}
+	routeActions := []*computepb.HttpRouteAction{
+		urlMap.DefaultRouteAction,
+	}
+
+	if urlMap.DefaultService != nil {
+		servicePaths = append(servicePaths, urlMap.DefaultService)
+	}
+
+	for _, matcher := range urlMap.PathMatchers {
+		routeActions = append(routeActions, matcher.DefaultRouteAction)
+
+		if matcher.DefaultService != nil {
+			servicePaths = append(servicePaths, matcher.DefaultService)
+		}
+
+		for _, rule := range matcher.RouteRules {
+			routeActions = append(routeActions, rule.RouteAction)
+		}
+	}
+
+	for _, action := range routeActions {
+		if action == nil || action.FaultInjectionPolicy != nil {
+			continue
+		}
+
+		for _, wbs := range action.WeightedBackendServices {
+			servicePaths = append(servicePaths, wbs.BackendService)
+		}
+	}
+
+	return servicePaths
+}
+
+// urlMapBackendServicePaths returns the eligible backend services of the URL map.
+func urlMapBackendServicePaths(urlMap *computepb.UrlMap) []*string {
+	backendServicePaths := []*string{}
+
+	for _, path := range urlMapServicePaths(urlMap) {
+		if !strings.Contains(*path, "/backendBuckets/") {
+			backendServicePaths = append(backendServicePaths, path)
+		}
+	}
+
+	return backendServicePaths
+}
+
+// urlMapBackendBucketPaths returns the eligible backend buckets of the URL map.
+func urlMapBackendBucketPaths(urlMap *computepb.UrlMap) []*string {
+	backendBucketPaths := []*string{}
+
+	for _, path := range urlMapServicePaths(urlMap) {
+		if strings.Contains(*path, "/backendBuckets/") {
+			backendBucketPaths = append(backendBucketPaths, path)
+		}
+	}
+
+	return backendBucketPaths
+}
+
+// getBackendService fetches the backend service referenced by the given path, in the project it names.
+func (p *GKEGatewayProviderData) getBackendService(ctx context.Context, project string, region types.String, path string) (*computepb.BackendService, diag.Diagnostics) {
+	var (
+		backendService *computepb.BackendService
+		diags          diag.Diagnostics
+		err            error
+	)
+
+	project = selfLinkProject(path, project)
+	backendServiceComponents := strings.Split(path, "/")
+
+	if region.IsNull() {
+		backendService, err = p.backendServicesClient.Get(ctx, &computepb.GetBackendServiceRequest{
+			BackendService: backendServiceComponents[len(backendServiceComponents)-1],
+			Project:        project,
+		})
+	} else {
+		backendService, err = p.regionBackendServicesClient.Get(ctx, &computepb.GetRegionBackendServiceRequest{
+			BackendService: backendServiceComponents[len(backendServiceComponents)-1],
+			Region:         region.ValueString(),
+			Project:        project,
+		})
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up backend service %s", backendServiceComponents[len(backendServiceComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	return backendService, diags
+}
+
+// lookupBackendService resolves the single backend service behind the load balancer created for the given Kubernetes
+// gateway. Both return values are nil when no forwarding rule exists for the gateway.
+func (p *GKEGatewayProviderData) lookupBackendService(ctx context.Context, project string, region types.String, namespace string, gateway string) (*computepb.BackendService, diag.Diagnostics) {
+	forwardingRule, diags := p.findGatewayForwardingRule(ctx, project, region, namespace, gateway)
+
+	if diags.HasError() || forwardingRule == nil {
+		return nil, diags
+	}
+
+	backendService, backendServiceDiags := p.forwardingRuleBackendService(ctx, project, region, forwardingRule, urlMapRoute{})
+	diags.Append(backendServiceDiags...)
+
+	return backendService, diags
+}
+
+// forwardingRuleBackendService resolves the single backend service behind the forwarding rule, along the given route.
+func (p *GKEGatewayProviderData) forwardingRuleBackendService(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule, route urlMapRoute) (*computepb.BackendService, diag.Diagnostics) {
+	start := time.Now()
+
+	backendServicePaths, diags := p.forwardingRuleBackendServicePaths(ctx, project, region, forwardingRule, route)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(backendServicePaths) == 0 {
+		diags.AddError("No backend services found", noBackendServicesDetail(forwardingRule, route))
+		return nil, diags
+	} else if len(backendServicePaths) > 1 {
+		debugMessage := "The following backend services matched:\n\n"
+		for _, path := range backendServicePaths {
+			components := strings.Split(*path, "/")
+			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, components[len(components)-1])
+		}
+
+		diags.AddError("Multiple backend services found", debugMessage)
+		return nil, diags
+	}
+
+	// Finally, lookup the backend service.
+	backendService, backendServiceDiags := p.getBackendService(ctx, project, region, *backendServicePaths[0])
+	diags.Append(backendServiceDiags...)
+
+	p.metrics.record("backend_service_resolution", start, 1)
+
+	return backendService, diags
+}
+
+// forwardingRuleBackendServicePaths follows the forwarding rule to the paths of the eligible backend services behind it,
+// along the given route. The route doesn't apply to L4 proxies, which have a single backend service.
+func (p *GKEGatewayProviderData) forwardingRuleBackendServicePaths(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule, route urlMapRoute) ([]*string, diag.Diagnostics) {
+	proxy, diags := p.getTargetProxy(ctx, project, region, forwardingRule)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// L4 proxies reference the backend service directly.
+	if proxy.service != "" {
+		return []*string{&proxy.service}, diags
+	}
+
+	urlMap, urlMapDiags := p.getUrlMapByPath(ctx, project, region, proxy.urlMap)
+	diags.Append(urlMapDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return urlMapBackendServicePaths(route.narrow(urlMap)), diags
+}
+
+// noBackendServicesDetail explains a forwarding rule resolving to no backend service along the route, which happens
+// when the URL map has no rule for it, such as while GKE is still syncing the routes attached to the gateway.
+func noBackendServicesDetail(forwardingRule *computepb.ForwardingRule, route urlMapRoute) string {
+	if route == (urlMapRoute{}) {
+		return fmt.Sprintf("The URL map behind the %s forwarding rule references no backend service. Check the routes attached "+
+			"to the gateway were accepted, and that GKE has finished syncing them to the load balancer.", forwardingRule.GetName())
+	}
+
+	return fmt.Sprintf("The URL map behind the %s forwarding rule routes no request for %s to a backend service. Check the "+
+		"hostname, path and route_rule_priority match a rule of the routes attached to the gateway, and that GKE has finished "+
+		"syncing them to the load balancer.", forwardingRule.GetName(), route.describe())
+}
+
+// getBackendServices fetches the backend services referenced by the given paths concurrently, in the order of the
+// paths and without duplicates.
+func (p *GKEGatewayProviderData) getBackendServices(ctx context.Context, project string, region types.String, paths []*string) ([]*computepb.BackendService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	keys := []string{}
+	for _, path := range paths {
+		keys = append(keys, *path)
+	}
+
+	results := lookupConcurrently(ctx, keys, func(ctx context.Context, path string) (*computepb.BackendService, diag.Diagnostics) {
+		return p.getBackendService(ctx, project, region, path)
+	})
+
+	backendServices := []*computepb.BackendService{}
+	seen := map[string]bool{}
+
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		diags.Append(results[key].diags...)
+		backendServices = append(backendServices, results[key].value)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return backendServices, diags
+}
+
+// lookupServiceBackendService resolves the single backend service GKE created for the given Kubernetes Service, based
+// on the description it writes on the backend service. A port of 0 matches any port. Both return values are nil when
+// the project doesn't exist yet.
+func (p *GKEGatewayProviderData) lookupServiceBackendService(ctx context.Context, project string, region types.String, namespace string, service string, port int64) (*computepb.BackendService, diag.Diagnostics) {
+	matchingBackendServices, diags := p.findServiceBackendServices(ctx, project, region, namespace, service, port)
+
+	if diags.HasError() || len(matchingBackendServices) == 0 {
+		return nil, diags
+	} else if len(matchingBackendServices) > 1 {
+		debugMessage := "The following backend services matched, use port to select one:\n\n"
+		for _, backendService := range matchingBackendServices {
+			debugMessage = fmt.Sprintf("%s  - %s\n", debugMessage, backendService.GetName())
+		}
+
+		diags.AddError("Multiple backend services found", debugMessage)
+		return nil, diags
+	}
+
+	return matchingBackendServices[0], diags
+}
+
+// findServiceBackendServices returns the backend services GKE created for the given Kubernetes Service, based on the
+// description it writes on the backend service. A port of 0 matches any port. Nothing is returned when the project
+// doesn't exist yet.
+func (p *GKEGatewayProviderData) findServiceBackendServices(ctx context.Context, project string, region types.String, namespace string, service string, port int64) ([]*computepb.BackendService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	start := time.Now()
+
+	// Loop over the backend services.
+	var backendServicesIterator *compute.BackendServiceIterator
+	if region.IsNull() {
+		backendServicesIterator = p.backendServicesClient.List(ctx, &computepb.ListBackendServicesRequest{
+			Project: project,
+		})
+	} else {
+		backendServicesIterator = p.regionBackendServicesClient.List(ctx, &computepb.ListRegionBackendServicesRequest{
+			Project: project,
+			Region:  region.ValueString(),
+		})
+	}
+
+	matchingBackendServices := make([]*computepb.BackendService, 0)
+
+	for {
+		backendService, err := backendServicesIterator.Next()
+
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			// Ignore 404 errors for projects that don't exist yet.
+			if e, ok := err.(*apierror.APIError); ok && e.HTTPCode() == 404 {
+				return nil, diags
+			}
+
+			diags.AddError("Unable to iterate over backend services", fmt.Sprintf("Error calling Google API: %+v", err))
+			return nil, diags
+		}
+
+		if backendServiceMatchesService(backendService, namespace, service, port) {
+			matchingBackendServices = append(matchingBackendServices, backendService)
+		}
+	}
+
+	p.metrics.record("backend_service_scan", start, len(matchingBackendServices))
+
+	return matchingBackendServices, diags
+}
+
+// backendServiceMatchesService reports whether GKE created the backend service for the given Kubernetes Service, based
+// on the description it writes on the backend service. An empty service matches any service, and a port of 0 any port.
+func backendServiceMatchesService(backendService *computepb.BackendService, namespace string, service string, port int64) bool {
+	// Only the backend services created by GKE have a JSON description.
+	bsd := backendServiceDescription{}
+	if err := json.Unmarshal([]byte(backendService.GetDescription()), &bsd); err != nil || bsd.ServiceName == nil {
+		return false
+	}
+
+	if service != "" && *bsd.ServiceName != fmt.Sprintf("%s/%s", namespace, service) {
+		return false
+	}
+
+	return port == 0 || (bsd.ServicePort != nil && *bsd.ServicePort == strconv.FormatInt(port, 10))
+}
+
+// withFields returns the context of the compute API calls requesting a partial response with only the given fields.
+// The X-Goog-FieldMask header is the HTTP header form of the fields system parameter, taking the same syntax of
+// comma-separated fields with the subfields of a field in parentheses, see
+// https://cloud.google.com/apis/docs/system-parameters. The REST clients have no way to add query parameters.
+func withFields(ctx context.Context, fields string) context.Context {
+	return callctx.SetHeaders(ctx, "X-Goog-FieldMask", fields)
+}
@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestFieldMasks checks the partial responses the lookups request keep every field they read, by pruning messages
+// with every field set down to the mask like the API does.
+func TestFieldMasks(t *testing.T) {
+	tests := map[string]struct {
+		fields  string
+		message proto.Message
+		reads   map[string]func(proto.Message) any
+	}{
+		"forwardingRuleListFields": {
+			fields:  forwardingRuleListFields,
+			message: &computepb.ForwardingRuleList{},
+			reads: forwardingRuleListReads(map[string]func(*computepb.ForwardingRuleList) any{
+				"items.IPAddress":           func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetIPAddress() },
+				"items.description":         func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetDescription() },
+				"items.labels":              func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetLabels() },
+				"items.loadBalancingScheme": func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetLoadBalancingScheme() },
+				"items.name":                func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetName() },
+				"items.network":             func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetNetwork() },
+				"items.portRange":           func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetPortRange() },
+				"items.selfLink":            func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetSelfLink() },
+				"items.subnetwork":          func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetSubnetwork() },
+				"items.target":              func(l *computepb.ForwardingRuleList) any { return first(l.GetItems()).GetTarget() },
+				"nextPageToken":             func(l *computepb.ForwardingRuleList) any { return l.GetNextPageToken() },
+				"warning.code":              func(l *computepb.ForwardingRuleList) any { return l.GetWarning().GetCode() },
+				"warning.message":           func(l *computepb.ForwardingRuleList) any { return l.GetWarning().GetMessage() },
+			}),
+		},
+		"urlMapFields": {
+			fields:  urlMapFields,
+			message: &computepb.UrlMap{},
+			reads: urlMapReads(map[string]func(*computepb.UrlMap) any{
+				"defaultRouteAction.faultInjectionPolicy": func(u *computepb.UrlMap) any { return u.GetDefaultRouteAction().GetFaultInjectionPolicy() },
+				"defaultRouteAction.weightedBackendServices.backendService": func(u *computepb.UrlMap) any {
+					return first(u.GetDefaultRouteAction().GetWeightedBackendServices()).GetBackendService()
+				},
+				"defaultService":              func(u *computepb.UrlMap) any { return u.GetDefaultService() },
+				"defaultUrlRedirect":          func(u *computepb.UrlMap) any { return u.GetDefaultUrlRedirect() },
+				"hostRules.hosts":             func(u *computepb.UrlMap) any { return first(u.GetHostRules()).GetHosts() },
+				"hostRules.pathMatcher":       func(u *computepb.UrlMap) any { return first(u.GetHostRules()).GetPathMatcher() },
+				"name":                        func(u *computepb.UrlMap) any { return u.GetName() },
+				"pathMatchers.name":           func(u *computepb.UrlMap) any { return first(u.GetPathMatchers()).GetName() },
+				"pathMatchers.defaultService": func(u *computepb.UrlMap) any { return first(u.GetPathMatchers()).GetDefaultService() },
+				"pathMatchers.defaultRouteAction.faultInjectionPolicy": func(u *computepb.UrlMap) any {
+					return first(u.GetPathMatchers()).GetDefaultRouteAction().GetFaultInjectionPolicy()
+				},
+				"pathMatchers.defaultRouteAction.weightedBackendServices.backendService": func(u *computepb.UrlMap) any {
+					return first(first(u.GetPathMatchers()).GetDefaultRouteAction().GetWeightedBackendServices()).GetBackendService()
+				},
+				"pathMatchers.pathRules.paths": func(u *computepb.UrlMap) any { return first(first(u.GetPathMatchers()).GetPathRules()).GetPaths() },
+				"pathMatchers.pathRules.routeAction.faultInjectionPolicy": func(u *computepb.UrlMap) any {
+					return first(first(u.GetPathMatchers()).GetPathRules()).GetRouteAction().GetFaultInjectionPolicy()
+				},
+				"pathMatchers.pathRules.routeAction.weightedBackendServices.backendService": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetPathRules()).GetRouteAction().GetWeightedBackendServices()).GetBackendService()
+				},
+				"pathMatchers.pathRules.service": func(u *computepb.UrlMap) any { return first(first(u.GetPathMatchers()).GetPathRules()).GetService() },
+				"pathMatchers.routeRules.matchRules.fullPathMatch": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetRouteRules()).GetMatchRules()).GetFullPathMatch()
+				},
+				"pathMatchers.routeRules.matchRules.ignoreCase": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetRouteRules()).GetMatchRules()).GetIgnoreCase()
+				},
+				"pathMatchers.routeRules.matchRules.pathTemplateMatch": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetRouteRules()).GetMatchRules()).GetPathTemplateMatch()
+				},
+				"pathMatchers.routeRules.matchRules.prefixMatch": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetRouteRules()).GetMatchRules()).GetPrefixMatch()
+				},
+				"pathMatchers.routeRules.matchRules.regexMatch": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetRouteRules()).GetMatchRules()).GetRegexMatch()
+				},
+				"pathMatchers.routeRules.priority": func(u *computepb.UrlMap) any { return first(first(u.GetPathMatchers()).GetRouteRules()).GetPriority() },
+				"pathMatchers.routeRules.routeAction.faultInjectionPolicy": func(u *computepb.UrlMap) any {
+					return first(first(u.GetPathMatchers()).GetRouteRules()).GetRouteAction().GetFaultInjectionPolicy()
+				},
+				"pathMatchers.routeRules.routeAction.maxStreamDuration.nanos": func(u *computepb.UrlMap) any {
+					return first(first(u.GetPathMatchers()).GetRouteRules()).GetRouteAction().GetMaxStreamDuration().GetNanos()
+				},
+				"pathMatchers.routeRules.routeAction.maxStreamDuration.seconds": func(u *computepb.UrlMap) any {
+					return first(first(u.GetPathMatchers()).GetRouteRules()).GetRouteAction().GetMaxStreamDuration().GetSeconds()
+				},
+				"pathMatchers.routeRules.routeAction.weightedBackendServices.backendService": func(u *computepb.UrlMap) any {
+					return first(first(first(u.GetPathMatchers()).GetRouteRules()).GetRouteAction().GetWeightedBackendServices()).GetBackendService()
+				},
+				"pathMatchers.routeRules.service": func(u *computepb.UrlMap) any { return first(first(u.GetPathMatchers()).GetRouteRules()).GetService() },
+				"selfLink":                        func(u *computepb.UrlMap) any { return u.GetSelfLink() },
+			}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mask, err := parseFieldMask(test.fields)
+			if err != nil {
+				t.Fatalf("invalid mask: %s", err)
+			}
+
+			checkFieldMask(t, "", test.message.ProtoReflect().Descriptor(), mask)
+
+			message := test.message.ProtoReflect().New()
+			fillMessage(message, map[protoreflect.FullName]bool{})
+			pruneMessage(message, mask)
+
+			for field, read := range test.reads {
+				if reflect.ValueOf(read(message.Interface())).IsZero() {
+					t.Errorf("%s is read but not in the mask", field)
+				}
+			}
+		})
+	}
+}
+
+// fieldMask is a parsed partial response mask, with the subfields of each field, none meaning all of them.
+type fieldMask map[string]fieldMask
+
+// parseFieldMask parses the syntax of the fields system parameter, such as `items(name,selfLink),nextPageToken`.
+func parseFieldMask(fields string) (fieldMask, error) {
+	mask, rest, err := parseFieldMaskList(fields)
+	if err == nil && rest != "" {
+		err = &fieldMaskError{rest}
+	}
+
+	return mask, err
+}
+
+func parseFieldMaskList(fields string) (fieldMask, string, error) {
+	mask := fieldMask{}
+
+	for {
+		end := strings.IndexAny(fields, ",()")
+		if end == -1 {
+			end = len(fields)
+		}
+
+		name := fields[:end]
+		if name == "" {
+			return nil, fields, &fieldMaskError{fields}
+		}
+
+		fields = fields[end:]
+		mask[name] = nil
+
+		if strings.HasPrefix(fields, "(") {
+			subfields, rest, err := parseFieldMaskList(fields[1:])
+			if err != nil {
+				return nil, rest, err
+			}
+
+			if !strings.HasPrefix(rest, ")") {
+				return nil, rest, &fieldMaskError{rest}
+			}
+
+			mask[name] = subfields
+			fields = rest[1:]
+		}
+
+		if !strings.HasPrefix(fields, ",") {
+			return mask, fields, nil
+		}
+
+		fields = fields[1:]
+	}
+}
+
+type fieldMaskError struct {
+	rest string
+}
+
+func (e *fieldMaskError) Error() string {
+	return "unexpected `" + e.rest + "`"
+}
+
+// checkFieldMask reports the fields of the mask the message doesn't have, and the subfields of fields without any.
+func checkFieldMask(t *testing.T, prefix string, descriptor protoreflect.MessageDescriptor, mask fieldMask) {
+	t.Helper()
+
+	for name, subfields := range mask {
+		field := fieldByJSONName(descriptor, name)
+
+		if field == nil {
+			t.Errorf("%s%s is not a field", prefix, name)
+			continue
+		}
+
+		if subfields == nil {
+			continue
+		}
+
+		if field.Message() == nil || field.IsMap() {
+			t.Errorf("%s%s has no subfields", prefix, name)
+			continue
+		}
+
+		checkFieldMask(t, prefix+name+".", field.Message(), subfields)
+	}
+}
+
+func fieldByJSONName(descriptor protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := descriptor.Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		if fields.Get(i).JSONName() == name {
+			return fields.Get(i)
+		}
+	}
+
+	return nil
+}
+
+// fillMessage sets every field of the message, with a single element for the lists and maps, stopping at recursive
+// messages.
+func fillMessage(message protoreflect.Message, parents map[protoreflect.FullName]bool) {
+	descriptor := message.Descriptor()
+
+	if parents[descriptor.FullName()] {
+		return
+	}
+
+	parents[descriptor.FullName()] = true
+	defer delete(parents, descriptor.FullName())
+
+	fields := descriptor.Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		switch {
+		case field.IsMap():
+			message.Mutable(field).Map().Set(fillValue(message.NewField(field).Map().NewValue, field.MapKey(), parents).MapKey(), fillValue(message.NewField(field).Map().NewValue, field.MapValue(), parents))
+		case field.IsList():
+			list := message.Mutable(field).List()
+			list.Append(fillValue(list.NewElement, field, parents))
+		default:
+			message.Set(field, fillValue(func() protoreflect.Value { return message.NewField(field) }, field, parents))
+		}
+	}
+}
+
+func fillValue(newValue func() protoreflect.Value, field protoreflect.FieldDescriptor, parents map[protoreflect.FullName]bool) protoreflect.Value {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte("x"))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(1)
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(1)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(1)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(1)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(1)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		value := newValue()
+		fillMessage(value.Message(), parents)
+
+		return value
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString("x")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(1)
+	default:
+		return protoreflect.ValueOfUint64(1)
+	}
+}
+
+// pruneMessage clears the fields of the message which aren't in the mask, like the API does for a partial response.
+func pruneMessage(message protoreflect.Message, mask fieldMask) {
+	pruned := []protoreflect.FieldDescriptor{}
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		subfields, ok := mask[field.JSONName()]
+
+		switch {
+		case !ok:
+			pruned = append(pruned, field)
+		case subfields == nil || field.Message() == nil || field.IsMap():
+		case field.IsList():
+			for i := 0; i < value.List().Len(); i++ {
+				pruneMessage(value.List().Get(i).Message(), subfields)
+			}
+		default:
+			pruneMessage(value.Message(), subfields)
+		}
+
+		return true
+	})
+
+	for _, field := range pruned {
+		message.Clear(field)
+	}
+}
+
+func forwardingRuleListReads(reads map[string]func(*computepb.ForwardingRuleList) any) map[string]func(proto.Message) any {
+	converted := map[string]func(proto.Message) any{}
+
+	for field, read := range reads {
+		converted[field] = func(message proto.Message) any { return read(message.(*computepb.ForwardingRuleList)) }
+	}
+
+	return converted
+}
+
+func urlMapReads(reads map[string]func(*computepb.UrlMap) any) map[string]func(proto.Message) any {
+	converted := map[string]func(proto.Message) any{}
+
+	for field, read := range reads {
+		converted[field] = func(message proto.Message) any { return read(message.(*computepb.UrlMap)) }
+	}
+
+	return converted
+}
+
+// first returns the first element of the slice, or the zero value when it is empty.
+func first[T any](s []T) T {
+	var zero T
+
+	if len(s) == 0 {
+		return zero
+	}
+
+	return s[0]
+}
+
+func TestSelfLinkProject(t *testing.T) {
+	tests := map[string]struct {
+		selfLink string
+		expected string
+	}{
+		"self_link": {
+			selfLink: "https://www.googleapis.com/compute/v1/projects/my-host-project/global/backendServices/my-backend-service",
+			expected: "my-host-project",
+		},
+		"beta self_link": {
+			selfLink: "https://www.googleapis.com/compute/beta/projects/my-host-project/regions/us-central1/urlMaps/my-url-map",
+			expected: "my-host-project",
+		},
+		"relative path": {
+			selfLink: "projects/my-host-project/zones/us-central1-a/networkEndpointGroups/my-neg",
+			expected: "my-host-project",
+		},
+		"bare name": {
+			selfLink: "my-backend-service",
+			expected: "my-gcp-project",
+		},
+		"empty": {
+			selfLink: "",
+			expected: "my-gcp-project",
+		},
+		"trailing projects": {
+			selfLink: "https://www.googleapis.com/compute/v1/projects",
+			expected: "my-gcp-project",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := selfLinkProject(test.selfLink, "my-gcp-project"); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// usageMetrics appends anonymized usage events to a local file as JSON lines. Only the kind of operation, how long it
+// took and how many items it returned are recorded, never the names of projects, gateways or components. Every
+// provider process appends to the same file, so a nil usageMetrics, when the setting is off, records nothing.
+type usageMetrics struct {
+	mu   sync.Mutex
+	path string
+}
+
+type usageMetricsEvent struct {
+	Count           int     `json:"count"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Event           string  `json:"event"`
+	Time            string  `json:"time"`
+}
+
+// recordCache appends the outcome of a cache lookup which started at the given time, as the event of the cache with a
+// suffix of either _hit or _miss, so the hit rate of each cache can be computed from the file.
+func (m *usageMetrics) recordCache(cache string, start time.Time, hit bool, count int) {
+	if hit {
+		m.record(cache+"_hit", start, count)
+	} else {
+		m.record(cache+"_miss", start, count)
+	}
+}
+
+// record appends an event which started at the given time. Metrics are best effort, failing to write them never fails
+// the operation.
+func (m *usageMetrics) record(event string, start time.Time, count int) {
+	if m == nil {
+		return
+	}
+
+	line, err := json.Marshal(usageMetricsEvent{
+		Count:           count,
+		DurationSeconds: time.Since(start).Seconds(),
+		Event:           event,
+		Time:            start.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, _ = file.Write(append(line, '\n'))
+}
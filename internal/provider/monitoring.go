@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// loadBalancerMetric returns the monitored resource type and full metric type under which the load balancer behind the
+// forwarding rule reports the given metric, such as `request_count`.
+func loadBalancerMetric(region types.String, forwardingRule *computepb.ForwardingRule, metric string) (string, string) {
+	// Each flavour of application load balancer reports under a different prefix.
+	if forwardingRule.GetLoadBalancingScheme() == "INTERNAL_MANAGED" {
+		return "internal_http_lb_rule", fmt.Sprintf("loadbalancing.googleapis.com/https/internal/%s", metric)
+	} else if !region.IsNull() {
+		return "http_external_regional_lb_rule", fmt.Sprintf("loadbalancing.googleapis.com/https/external/regional/%s", metric)
+	}
+
+	return "https_lb_rule", fmt.Sprintf("loadbalancing.googleapis.com/https/%s", metric)
+}
+
+// parseMonitoringLookback parses the lookback window, which Cloud Monitoring needs to be at least a minute long.
+func parseMonitoringLookback(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	lookback, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("lookback"), "Invalid lookback", fmt.Sprintf("The lookback must be a duration such as `1h`: %s", err))
+		return 0, diags
+	}
+
+	if lookback < time.Minute {
+		diags.AddAttributeError(path.Root("lookback"), "Invalid lookback", "The lookback must be at least `1m`.")
+		return 0, diags
+	}
+
+	return lookback, diags
+}
@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/iterator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource             = &NegEndpointResource{}
+	_ resource.ResourceWithIdentity = &NegEndpointResource{}
+)
+
+func NewNegEndpointResource() resource.Resource {
+	return &NegEndpointResource{}
+}
+
+// NegEndpointResource defines the resource implementation.
+type NegEndpointResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// NegEndpointResourceModel describes the resource data model.
+type NegEndpointResourceModel struct {
+	Endpoints            []NegEndpointResourceModelEndpoint `tfsdk:"endpoints"`
+	Gateway              types.String                       `tfsdk:"gateway"`
+	ID                   types.String                       `tfsdk:"id"`
+	Namespace            types.String                       `tfsdk:"namespace"`
+	NetworkEndpointGroup types.String                       `tfsdk:"network_endpoint_group"`
+	Project              types.String                       `tfsdk:"project"`
+	Region               types.String                       `tfsdk:"region"`
+	Zone                 types.String                       `tfsdk:"zone"`
+}
+
+type NegEndpointResourceModelEndpoint struct {
+	Instance  types.String `tfsdk:"instance"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	Port      types.Int64  `tfsdk:"port"`
+}
+
+// key identifies the endpoint within its network endpoint group.
+func (e NegEndpointResourceModelEndpoint) key() string {
+	return fmt.Sprintf("%s/%s:%d", e.Instance.ValueString(), e.IPAddress.ValueString(), e.Port.ValueInt64())
+}
+
+func (r *NegEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *NegEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data NegEndpointResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendService, diags := r.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if backendService == nil {
+		resp.Diagnostics.AddError("No backend service found", fmt.Sprintf("No load balancer was found for gateway %s/%s, it may not have been programmed by GKE yet.", data.Namespace.ValueString(), data.Gateway.ValueString()))
+		return
+	}
+
+	// The group self_link has the format projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{name}}.
+	for _, backend := range backendService.GetBackends() {
+		groupComponents := strings.Split(backend.GetGroup(), "/")
+
+		if len(groupComponents) < 4 || groupComponents[len(groupComponents)-2] != "networkEndpointGroups" || groupComponents[len(groupComponents)-3] != data.Zone.ValueString() {
+			continue
+		}
+
+		data.ID = types.StringValue(backend.GetGroup())
+		data.NetworkEndpointGroup = types.StringValue(groupComponents[len(groupComponents)-1])
+	}
+
+	if data.ID.IsUnknown() {
+		resp.Diagnostics.AddError("No network endpoint group found", fmt.Sprintf("The %s backend service of gateway %s/%s has no network endpoint group in the %s zone.", backendService.GetName(), data.Namespace.ValueString(), data.Gateway.ValueString(), data.Zone.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(r.attach(ctx, project, &data, data.Endpoints)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("zone"), data.Zone)...)
+}
+
+func (r *NegEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data NegEndpointResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, _, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only detach the endpoints that are still attached, the group may be gone altogether.
+	attached, diags := r.listEndpoints(ctx, project, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || attached == nil {
+		return
+	}
+
+	endpoints := []NegEndpointResourceModelEndpoint{}
+	for _, endpoint := range data.Endpoints {
+		if attached[endpoint.key()] {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	resp.Diagnostics.Append(r.detach(ctx, project, &data, endpoints)...)
+}
+
+func (r *NegEndpointResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	// The network endpoint groups of a gateway are zonal.
+	attributes := gatewayIdentityAttributes()
+	attributes["zone"] = identityschema.StringAttribute{
+		Description:       "The zone of the network endpoint group.",
+		RequiredForImport: true,
+	}
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *NegEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_neg_endpoint"
+}
+
+func (r *NegEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data NegEndpointResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, _, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attached, diags := r.listEndpoints(ctx, project, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The gateway is gone, and its network endpoint groups with it.
+	if attached == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Endpoints detached behind our back, by the NEG controller or someone else, are attached again on the next apply.
+	// The endpoints managed by the controller are none of this resource's business.
+	endpoints := []NegEndpointResourceModelEndpoint{}
+	for _, endpoint := range data.Endpoints {
+		if attached[endpoint.key()] {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	data.Endpoints = endpoints
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("zone"), data.Zone)...)
+}
+
+func (r *NegEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoints": schema.SetNestedAttribute{
+				MarkdownDescription: "The endpoints to attach to the network endpoint group.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance": schema.StringAttribute{
+							MarkdownDescription: "Name of the VM instance the endpoint runs on, which must be in the zone of the network endpoint group.",
+							Required:            true,
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "IP address of the endpoint, which must belong to the VM instance.",
+							Required:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port the endpoint serves on, between 1 and 65535.",
+							Required:            true,
+							Validators:          []validator.Int64{portValidator()},
+						},
+					},
+				},
+				Required: true,
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URI of the network endpoint group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"network_endpoint_group": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the network endpoint group the endpoints are attached to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{regionValidator()},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The zone of the network endpoint group to attach the endpoints to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+		MarkdownDescription: "Attaches additional endpoints, such as workloads still running on Compute Engine VMs, to the zonal network endpoint group GKE created behind the backend service of the load balancer for a Kubernetes Gateway resource. Only the configured endpoints are managed, the ones of the Service pods are left to the NEG controller, and endpoints it detaches are attached again on the next apply. The same single backend service limitations as the `gkegateway_backend_service` data source apply.",
+	}
+}
+
+func (r *NegEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data, state NegEndpointResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, _, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := map[string]bool{}
+	for _, endpoint := range data.Endpoints {
+		planned[endpoint.key()] = true
+	}
+
+	previous := map[string]bool{}
+	for _, endpoint := range state.Endpoints {
+		previous[endpoint.key()] = true
+	}
+
+	added, removed := []NegEndpointResourceModelEndpoint{}, []NegEndpointResourceModelEndpoint{}
+
+	for _, endpoint := range data.Endpoints {
+		if !previous[endpoint.key()] {
+			added = append(added, endpoint)
+		}
+	}
+
+	for _, endpoint := range state.Endpoints {
+		if !planned[endpoint.key()] {
+			removed = append(removed, endpoint)
+		}
+	}
+
+	resp.Diagnostics.Append(r.detach(ctx, project, &data, removed)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.attach(ctx, project, &data, added)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.providerData.setGatewayIdentity(ctx, resp.Identity, data.Project, data.Region, data.Namespace, data.Gateway)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("zone"), data.Zone)...)
+}
+
+// attach adds the endpoints to the network endpoint group and waits for the change to complete.
+func (r *NegEndpointResource) attach(ctx context.Context, project string, data *NegEndpointResourceModel, endpoints []NegEndpointResourceModelEndpoint) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(endpoints) == 0 {
+		return diags
+	}
+
+	operation, err := r.providerData.networkEndpointGroupsClient.AttachNetworkEndpoints(ctx, &computepb.AttachNetworkEndpointsNetworkEndpointGroupRequest{
+		NetworkEndpointGroup: data.NetworkEndpointGroup.ValueString(),
+		NetworkEndpointGroupsAttachEndpointsRequestResource: &computepb.NetworkEndpointGroupsAttachEndpointsRequest{
+			NetworkEndpoints: negNetworkEndpoints(endpoints),
+		},
+		Project: selfLinkProject(data.ID.ValueString(), project),
+		Zone:    data.Zone.ValueString(),
+	})
+
+	diags.Append(waitForNegOperation(ctx, operation, err, fmt.Sprintf("Error attaching endpoints to network endpoint group %s", data.NetworkEndpointGroup.ValueString()))...)
+
+	return diags
+}
+
+// detach removes the endpoints from the network endpoint group and waits for the change to complete.
+func (r *NegEndpointResource) detach(ctx context.Context, project string, data *NegEndpointResourceModel, endpoints []NegEndpointResourceModelEndpoint) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(endpoints) == 0 {
+		return diags
+	}
+
+	operation, err := r.providerData.networkEndpointGroupsClient.DetachNetworkEndpoints(ctx, &computepb.DetachNetworkEndpointsNetworkEndpointGroupRequest{
+		NetworkEndpointGroup: data.NetworkEndpointGroup.ValueString(),
+		NetworkEndpointGroupsDetachEndpointsRequestResource: &computepb.NetworkEndpointGroupsDetachEndpointsRequest{
+			NetworkEndpoints: negNetworkEndpoints(endpoints),
+		},
+		Project: selfLinkProject(data.ID.ValueString(), project),
+		Zone:    data.Zone.ValueString(),
+	})
+
+	diags.Append(waitForNegOperation(ctx, operation, err, fmt.Sprintf("Error detaching endpoints from network endpoint group %s", data.NetworkEndpointGroup.ValueString()))...)
+
+	return diags
+}
+
+// listEndpoints returns the keys of every endpoint attached to the network endpoint group, or nil when the group
+// doesn't exist anymore.
+func (r *NegEndpointResource) listEndpoints(ctx context.Context, project string, data *NegEndpointResourceModel) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpointsIterator := r.providerData.networkEndpointGroupsClient.ListNetworkEndpoints(ctx, &computepb.ListNetworkEndpointsNetworkEndpointGroupsRequest{
+		NetworkEndpointGroup:                              data.NetworkEndpointGroup.ValueString(),
+		NetworkEndpointGroupsListEndpointsRequestResource: &computepb.NetworkEndpointGroupsListEndpointsRequest{},
+		Project: selfLinkProject(data.ID.ValueString(), project),
+		Zone:    data.Zone.ValueString(),
+	})
+
+	attached := map[string]bool{}
+
+	for {
+		endpoint, err := endpointsIterator.Next()
+
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			if e, ok := err.(*apierror.APIError); ok && e.HTTPCode() == 404 {
+				return nil, diags
+			}
+
+			diags.AddError(fmt.Sprintf("Unable to iterate over the endpoints of network endpoint group %s", data.NetworkEndpointGroup.ValueString()), fmt.Sprintf("Error calling Google API: %+v", err))
+			return nil, diags
+		}
+
+		// The instance is returned as a self_link but configured by name.
+		instanceComponents := strings.Split(endpoint.GetNetworkEndpoint().GetInstance(), "/")
+
+		attached[NegEndpointResourceModelEndpoint{
+			Instance:  types.StringValue(instanceComponents[len(instanceComponents)-1]),
+			IPAddress: types.StringValue(endpoint.GetNetworkEndpoint().GetIpAddress()),
+			Port:      types.Int64Value(int64(endpoint.GetNetworkEndpoint().GetPort())),
+		}.key()] = true
+	}
+
+	return attached, diags
+}
+
+// negNetworkEndpoints converts the endpoints for the API.
+func negNetworkEndpoints(endpoints []NegEndpointResourceModelEndpoint) []*computepb.NetworkEndpoint {
+	networkEndpoints := []*computepb.NetworkEndpoint{}
+
+	for _, endpoint := range endpoints {
+		port := int32(endpoint.Port.ValueInt64())
+
+		networkEndpoints = append(networkEndpoints, &computepb.NetworkEndpoint{
+			Instance:  endpoint.Instance.ValueStringPointer(),
+			IpAddress: endpoint.IPAddress.ValueStringPointer(),
+			Port:      &port,
+		})
+	}
+
+	return networkEndpoints
+}
+
+// waitForNegOperation waits for the operation started by a network endpoint group call to complete.
+func waitForNegOperation(ctx context.Context, operation *compute.Operation, err error, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err == nil {
+		err = operation.Wait(ctx)
+	}
+
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("Error calling Google API: %+v", err))
+	}
+
+	return diags
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNegEndpointResourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// missing fields
+			{
+				Config: `
+					resource "gkegateway_neg_endpoint" "example" {
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						zone      = "us-central1-a"
+						endpoints = [{ instance = "my-vm", ip_address = "10.0.0.2", port = 8080 }]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "gateway" is required, but no definition was found.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_neg_endpoint" "example" {
+						gateway   = "my-gateway-name"
+						project   = "my-gcp-project"
+						zone      = "us-central1-a"
+						endpoints = [{ instance = "my-vm", ip_address = "10.0.0.2", port = 8080 }]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "namespace" is required, but no definition was found.`),
+			},
+			{
+				Config: `
+					resource "gkegateway_neg_endpoint" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						endpoints = [{ instance = "my-vm", ip_address = "10.0.0.2", port = 8080 }]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "zone" is required, but no definition was found.`),
+			},
+			// invalid fields
+			{
+				Config: `
+					resource "gkegateway_neg_endpoint" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+						zone      = "us-central1-a"
+						endpoints = [{ instance = "my-vm", ip_address = "10.0.0.2", port = 65536 }]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`must be between 1 and 65535, got 65536`),
+			},
+		},
+	})
+}
+
+func TestNegEndpointResourcePortValidator(t *testing.T) {
+	tests := map[string]struct {
+		port  types.Int64
+		error bool
+	}{
+		"unknown": {port: types.Int64Unknown()},
+		"minimum": {port: types.Int64Value(1)},
+		"maximum": {port: types.Int64Value(65535)},
+		"zero":    {port: types.Int64Value(0), error: true},
+		"too big": {port: types.Int64Value(65536), error: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.Int64Response{}
+			portValidator().ValidateInt64(context.Background(), validator.Int64Request{
+				ConfigValue: test.port,
+				Path:        path.Root("endpoints").AtListIndex(0).AtName("port"),
+			}, resp)
+
+			if resp.Diagnostics.HasError() != test.error {
+				t.Errorf("expected an error: %t, got %v", test.error, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// maxNEGDescriptiveLabel is the length left to the namespace, service and port in the names of the NEGs, out of 63:
+// 5 for the k8s1- prefix, 8 for the cluster UID, 8 for the hash and 4 for the hyphens.
+const maxNEGDescriptiveLabel = 38
+
+// negName returns the name the NEG controller gives the network endpoint groups of the port of a Kubernetes service,
+// as k8s1-{{cluster UID}}-{{namespace}}-{{service}}-{{port}}-{{hash}}, where the descriptive fields are truncated to
+// fit, and the hash disambiguates the truncated ones.
+func negName(clusterUID string, namespace string, service string, port int64) string {
+	if len(clusterUID) > 8 {
+		clusterUID = clusterUID[:8]
+	}
+
+	portString := strconv.FormatInt(port, 10)
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join([]string{clusterUID, namespace, service, portString}, ";"))))
+
+	fields := trimFieldsEvenly(maxNEGDescriptiveLabel, namespace, service, portString)
+
+	return fmt.Sprintf("k8s1-%s-%s-%s-%s-%s", clusterUID, fields[0], fields[1], fields[2], hash[:8])
+}
+
+// trimFieldsEvenly truncates the fields so their total length fits in maxLength, like the NEG controller does,
+// truncating the longer fields more than the shorter ones.
+func trimFieldsEvenly(maxLength int, fields ...string) []string {
+	total := 0
+	for _, field := range fields {
+		total += len(field)
+	}
+
+	if total <= maxLength {
+		return fields
+	}
+
+	excess := total - maxLength
+	remaining := maxLength
+
+	lengths := []int{}
+	for _, field := range fields {
+		length := len(field) - len(field)*excess/total - 1
+		lengths = append(lengths, length)
+		remaining -= length
+	}
+
+	// The space left by the rounding goes to the first fields.
+	for i := range lengths {
+		if remaining > 0 {
+			lengths[i]++
+			remaining--
+		}
+	}
+
+	trimmed := []string{}
+	for i, field := range fields {
+		trimmed = append(trimmed, field[:lengths[i]])
+	}
+
+	return trimmed
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NegNameFunction{}
+
+func NewNegNameFunction() function.Function {
+	return &NegNameFunction{}
+}
+
+// NegNameFunction defines the function implementation.
+type NegNameFunction struct{}
+
+func (f *NegNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Computes the name GKE gives the network endpoint groups of a port of a Kubernetes service, such as `k8s1-1a2b3c4d-my-cool-app-my-service-8080-35ff6e1d`, so configurations can reference them before the NEG controller creates them, without a lookup. The zonal NEGs of the service share the name, in each of the zones of the cluster.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The UID of the cluster the NEG controller names the groups after, the `uid` of the `ingress-uid` ConfigMap in the `kube-system` namespace.",
+				Name:                "cluster_uid",
+			},
+			function.StringParameter{
+				MarkdownDescription: "Name of the Kubernetes namespace the service is in.",
+				Name:                "namespace",
+			},
+			function.StringParameter{
+				MarkdownDescription: "Name of the Kubernetes service.",
+				Name:                "service",
+			},
+			function.Int64Parameter{
+				MarkdownDescription: "The port of the service.",
+				Name:                "port",
+			},
+		},
+		Return:  function.StringReturn{},
+		Summary: "Compute the name of the network endpoint groups of a Kubernetes service",
+	}
+}
+
+func (f *NegNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "neg_name"
+}
+
+func (f *NegNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clusterUID, namespace, service string
+	var port int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &clusterUID, &namespace, &service, &port))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if clusterUID == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The cluster_uid cannot be empty.")
+		return
+	}
+
+	if !rfc1123LabelPattern.MatchString(namespace) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%q is not the name of a Kubernetes namespace.", namespace))
+		return
+	}
+
+	if !rfc1123LabelPattern.MatchString(service) {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("%q is not the name of a Kubernetes service.", service))
+		return
+	}
+
+	if port < 1 || port > 65535 {
+		resp.Error = function.NewArgumentFuncError(3, "The port must be between 1 and 65535.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, negName(clusterUID, namespace, service, port)))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNegNameFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::neg_name("1a2b3c4d5e6f7a8b", "my-cool-app", "my-service", 8080)
+					}
+				`,
+				Check: resource.TestCheckOutput("test", "k8s1-1a2b3c4d-my-cool-app-my-service-8080-35ff6e1d"),
+			},
+			// long names are truncated
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::neg_name("1a2b3c4d5e6f7a8b", "a-very-long-namespace-name-for-the-app", "a-very-long-service-name-as-well", 8080)
+					}
+				`,
+				Check: resource.TestCheckOutput("test", "k8s1-1a2b3c4d-a-very-long-namespac-a-very-long-serv-80-e753d5eb"),
+			},
+			// invalid port
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::neg_name("1a2b3c4d5e6f7a8b", "my-cool-app", "my-service", 0)
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The port must be between 1 and 65535.`),
+			},
+		},
+	})
+}
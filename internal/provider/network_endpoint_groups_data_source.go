@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetworkEndpointGroupsDataSource{}
+
+func NewNetworkEndpointGroupsDataSource() datasource.DataSource {
+	return &NetworkEndpointGroupsDataSource{}
+}
+
+// NetworkEndpointGroupsDataSource defines the data source implementation.
+type NetworkEndpointGroupsDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// NetworkEndpointGroupsDataSourceModel describes the data source data model.
+type NetworkEndpointGroupsDataSourceModel struct {
+	Gateway               types.String                                               `tfsdk:"gateway"`
+	Namespace             types.String                                               `tfsdk:"namespace"`
+	NetworkEndpointGroups []NetworkEndpointGroupsDataSourceModelNetworkEndpointGroup `tfsdk:"network_endpoint_groups"`
+	Project               types.String                                               `tfsdk:"project"`
+	Region                types.String                                               `tfsdk:"region"`
+}
+
+type NetworkEndpointGroupsDataSourceModelNetworkEndpointGroup struct {
+	Name     types.String `tfsdk:"name"`
+	SelfLink types.String `tfsdk:"self_link"`
+	Zone     types.String `tfsdk:"zone"`
+}
+
+func (d *NetworkEndpointGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *NetworkEndpointGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_endpoint_groups"
+}
+
+func (d *NetworkEndpointGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data NetworkEndpointGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendService, diags := d.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || backendService == nil {
+		return
+	}
+
+	// GKE attaches the zonal NEGs directly as backends, so everything can be derived from the group self_link which has
+	// the format projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{name}}.
+	data.NetworkEndpointGroups = []NetworkEndpointGroupsDataSourceModelNetworkEndpointGroup{}
+
+	for _, backend := range backendService.GetBackends() {
+		groupComponents := strings.Split(backend.GetGroup(), "/")
+
+		if len(groupComponents) < 4 || groupComponents[len(groupComponents)-2] != "networkEndpointGroups" {
+			continue
+		}
+
+		data.NetworkEndpointGroups = append(data.NetworkEndpointGroups, NetworkEndpointGroupsDataSourceModelNetworkEndpointGroup{
+			Name:     types.StringValue(groupComponents[len(groupComponents)-1]),
+			SelfLink: types.StringValue(backend.GetGroup()),
+			Zone:     types.StringValue(groupComponents[len(groupComponents)-3]),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *NetworkEndpointGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"network_endpoint_groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the network endpoint group.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the network endpoint group.",
+						},
+						"zone": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Zone the network endpoint group is in.",
+						},
+					},
+				},
+				MarkdownDescription: "The network endpoint groups attached as backends to the backend service - will be null if no backend service is found.",
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+		},
+		MarkdownDescription: "Finds the zonal network endpoint groups GKE created behind the backend service of the load balancer for a Kubernetes Gateway resource. The same single backend service limitations as the `gkegateway_backend_service` data source apply.",
+	}
+}
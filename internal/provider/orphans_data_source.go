@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrphansDataSource{}
+
+func NewOrphansDataSource() datasource.DataSource {
+	return &OrphansDataSource{}
+}
+
+// OrphansDataSource defines the data source implementation.
+type OrphansDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// OrphansDataSourceModel describes the data source data model.
+type OrphansDataSourceModel struct {
+	BackendServices []types.String                         `tfsdk:"backend_services"`
+	ForwardingRules []OrphansDataSourceModelForwardingRule `tfsdk:"forwarding_rules"`
+	Gateways        []types.String                         `tfsdk:"gateways"`
+	Project         types.String                           `tfsdk:"project"`
+	Region          types.String                           `tfsdk:"region"`
+	TargetProxies   []types.String                         `tfsdk:"target_proxies"`
+	UrlMaps         []types.String                         `tfsdk:"url_maps"`
+}
+
+type OrphansDataSourceModelForwardingRule struct {
+	Gateway   types.String `tfsdk:"gateway"`
+	Namespace types.String `tfsdk:"namespace"`
+	SelfLink  types.String `tfsdk:"self_link"`
+}
+
+// orphanComponents collects the self_links of the load balancer components behind a set of forwarding rules.
+type orphanComponents struct {
+	backendServices []string
+	seen            map[string]bool
+	targetProxies   []string
+	urlMaps         []string
+}
+
+// orphanLookup is the target proxy and URL map, if it has one, a forwarding rule was followed to.
+type orphanLookup struct {
+	proxy  *targetProxy
+	urlMap *computepb.UrlMap
+}
+
+// add appends the self_link to the list unless it was already collected.
+func (c *orphanComponents) add(list *[]string, selfLink string) {
+	if selfLink == "" || c.seen[selfLink] {
+		return
+	}
+
+	c.seen[selfLink] = true
+	*list = append(*list, selfLink)
+}
+
+// collect adds the target proxy, URL map and backend services the forwarding rule was followed to.
+func (c *orphanComponents) collect(lookup orphanLookup) {
+	c.add(&c.targetProxies, lookup.proxy.selfLink)
+
+	if lookup.urlMap == nil {
+		c.add(&c.backendServices, lookup.proxy.service)
+		return
+	}
+
+	c.add(&c.urlMaps, lookup.urlMap.GetSelfLink())
+
+	for _, backendServicePath := range urlMapBackendServicePaths(lookup.urlMap) {
+		c.add(&c.backendServices, *backendServicePath)
+	}
+}
+
+func (d *OrphansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *OrphansDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orphans"
+}
+
+func (d *OrphansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data OrphansDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayForwardingRules, diags := d.providerData.listGatewayForwardingRules(ctx, project, region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing := map[string]bool{}
+	for _, gateway := range data.Gateways {
+		existing[gateway.ValueString()] = true
+	}
+
+	// Components still used by a live gateway, such as the backend service of a Service routed to by several gateways,
+	// must not end up on the worklist.
+	live := &orphanComponents{seen: map[string]bool{}}
+	orphaned := &orphanComponents{seen: map[string]bool{}}
+
+	data.ForwardingRules = []OrphansDataSourceModelForwardingRule{}
+
+	forwardingRules := []*computepb.ForwardingRule{}
+	for _, gfr := range gatewayForwardingRules {
+		forwardingRules = append(forwardingRules, gfr.forwardingRule)
+	}
+
+	// The forwarding rules are followed concurrently, then collected in order so the lists don't depend on which call
+	// returns first.
+	lookups := lookupConcurrently(ctx, forwardingRules, func(ctx context.Context, forwardingRule *computepb.ForwardingRule) (orphanLookup, diag.Diagnostics) {
+		return d.lookup(ctx, project, region, forwardingRule)
+	})
+
+	for _, gfr := range gatewayForwardingRules {
+		components := live
+
+		if !existing[fmt.Sprintf("%s/%s", gfr.namespace, gfr.gateway)] {
+			components = orphaned
+
+			data.ForwardingRules = append(data.ForwardingRules, OrphansDataSourceModelForwardingRule{
+				Gateway:   types.StringValue(gfr.gateway),
+				Namespace: types.StringValue(gfr.namespace),
+				SelfLink:  types.StringValue(gfr.forwardingRule.GetSelfLink()),
+			})
+		}
+
+		lookup := lookups[gfr.forwardingRule]
+		resp.Diagnostics.Append(lookup.diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		components.collect(lookup.value)
+	}
+
+	data.BackendServices = orphanedSelfLinks(orphaned.backendServices, live)
+	data.TargetProxies = orphanedSelfLinks(orphaned.targetProxies, live)
+	data.UrlMaps = orphanedSelfLinks(orphaned.urlMaps, live)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrphansDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backend_services": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "URIs of the backend services only used by orphaned forwarding rules.",
+			},
+			"forwarding_rules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gateway": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the deleted Kubernetes gateway resource.",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the Kubernetes namespace the deleted gateway resource was in.",
+						},
+						"self_link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "URI of the forwarding rule.",
+						},
+					},
+				},
+				MarkdownDescription: "The forwarding rules whose description references a gateway that isn't in `gateways`.",
+			},
+			"gateways": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Kubernetes gateway resources that exist in the cluster, with format `{{namespace}}/{{name}}`, for instance from the `kubernetes_resources` data source of the Kubernetes provider.",
+				Required:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to search. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to search. If it is not provided, the provider region is used. When neither are provided, global load balancers are searched.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"target_proxies": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "URIs of the target proxies only used by orphaned forwarding rules.",
+			},
+			"url_maps": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "URIs of the URL maps only used by orphaned forwarding rules.",
+			},
+		},
+		MarkdownDescription: "Finds the load balancer components GKE left behind for Kubernetes Gateway resources that no longer exist, producing a cleanup worklist. The provider has no access to the cluster, so the gateways that do exist must be provided. Forwarding rules are matched on the description GKE writes on them, and the target proxies, URL maps and backend services are followed from there, leaving out the ones still used by a live gateway.",
+	}
+}
+
+// lookup follows the forwarding rule to the target proxy and URL map behind it.
+func (d *OrphansDataSource) lookup(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule) (orphanLookup, diag.Diagnostics) {
+	proxy, diags := d.providerData.getTargetProxy(ctx, project, region, forwardingRule)
+
+	if diags.HasError() {
+		return orphanLookup{}, diags
+	}
+
+	// L4 proxies reference the backend service directly.
+	if proxy.service != "" {
+		return orphanLookup{proxy: proxy}, diags
+	}
+
+	urlMap, urlMapDiags := d.providerData.getUrlMapByPath(ctx, project, region, proxy.urlMap)
+	diags.Append(urlMapDiags...)
+
+	if diags.HasError() {
+		return orphanLookup{}, diags
+	}
+
+	return orphanLookup{proxy: proxy, urlMap: urlMap}, diags
+}
+
+// orphanedSelfLinks returns the self_links that no live gateway uses.
+func orphanedSelfLinks(selfLinks []string, live *orphanComponents) []types.String {
+	orphaned := []types.String{}
+
+	for _, selfLink := range selfLinks {
+		if !live.seen[selfLink] {
+			orphaned = append(orphaned, types.StringValue(selfLink))
+		}
+	}
+
+	return orphaned
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrphansDataSourceValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// missing fields
+			{
+				Config: `
+					data "gkegateway_orphans" "example" {
+						project = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The argument "gateways" is required, but no definition was found.`),
+			},
+			{
+				Config: `
+					data "gkegateway_orphans" "example" {
+						gateways = ["my-cool-app/my-gateway-name"]
+						region   = "us-central1"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The project field must be set on either the provider or data source.`),
+			},
+		},
+	})
+}
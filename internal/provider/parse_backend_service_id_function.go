@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// backendServiceIDAttributeTypes are the attributes of the object parse_backend_service_id returns.
+var backendServiceIDAttributeTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"project": types.StringType,
+	"region":  types.StringType,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseBackendServiceIDFunction{}
+
+func NewParseBackendServiceIDFunction() function.Function {
+	return &ParseBackendServiceIDFunction{}
+}
+
+// ParseBackendServiceIDFunction defines the function implementation.
+type ParseBackendServiceIDFunction struct{}
+
+func (f *ParseBackendServiceIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Parses the ID or self_link of a backend service, such as the ones returned by the data sources of this provider, into an object of its `project`, `region` and `name`. The `region` is null for the global backend services, like the `region` attributes of this provider expect.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The self_link, for any API version, or ID, such as `projects/{{project}}/global/backendServices/{{name}}`, of the backend service.",
+				Name:                "id",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: backendServiceIDAttributeTypes,
+		},
+		Summary: "Parse a backend service ID into its project, region and name",
+	}
+}
+
+func (f *ParseBackendServiceIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_backend_service_id"
+}
+
+func (f *ParseBackendServiceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+
+	if resp.Error != nil {
+		return
+	}
+
+	// The ID has the format projects/{{project}}/global/backendServices/{{name}} or
+	// projects/{{project}}/regions/{{region}}/backendServices/{{name}}.
+	matches := computeSelfLinkPattern.FindStringSubmatch(id)
+	if matches == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not the self_link or ID of a backend service.", id))
+		return
+	}
+
+	components := strings.Split(matches[1], "/")
+
+	if components[len(components)-2] != "backendServices" || components[2] == "zones" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not the self_link or ID of a backend service.", id))
+		return
+	}
+
+	region := types.StringNull()
+	if components[2] == "regions" {
+		region = types.StringValue(components[3])
+	}
+
+	result, diags := types.ObjectValue(backendServiceIDAttributeTypes, map[string]attr.Value{
+		"name":    types.StringValue(components[len(components)-1]),
+		"project": types.StringValue(components[1]),
+		"region":  region,
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccParseBackendServiceIDFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					locals {
+						backend_service = provider::gkegateway::parse_backend_service_id("https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/backendServices/my-backend-service")
+					}
+
+					output "name" {
+						value = local.backend_service.name
+					}
+
+					output "project" {
+						value = local.backend_service.project
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("name", "my-backend-service"),
+					resource.TestCheckOutput("project", "my-gcp-project"),
+				),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::parse_backend_service_id("projects/my-gcp-project/regions/us-central1/backendServices/my-backend-service").region
+					}
+				`,
+				Check: resource.TestCheckOutput("test", "us-central1"),
+			},
+			// not a backend service
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::parse_backend_service_id("projects/my-gcp-project/global/urlMaps/my-url-map")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`is not the self_link or ID of a backend service`),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// selfLinkAttributeTypes are the attributes of the object parse_self_link returns.
+var selfLinkAttributeTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"project": types.StringType,
+	"region":  types.StringType,
+	"scope":   types.StringType,
+	"type":    types.StringType,
+	"zone":    types.StringType,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseSelfLinkFunction{}
+
+func NewParseSelfLinkFunction() function.Function {
+	return &ParseSelfLinkFunction{}
+}
+
+// ParseSelfLinkFunction defines the function implementation.
+type ParseSelfLinkFunction struct{}
+
+func (f *ParseSelfLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Parses the self_link or ID of any compute resource, such as the ones returned by the data sources of this provider, into an object of its `project`, `scope`, `type` and `name`. The `scope` is either `global`, `regions/{{region}}` or `zones/{{zone}}`, and the `region` and `zone` hold the name of the region or zone of the regional and zonal resources, and are null otherwise. The `type` is the collection of the resource in the compute API, such as `backendServices`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The self_link, for any API version, or ID, such as `projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{name}}`, of the resource.",
+				Name:                "self_link",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: selfLinkAttributeTypes,
+		},
+		Summary: "Parse a compute self_link into its components",
+	}
+}
+
+func (f *ParseSelfLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_self_link"
+}
+
+func (f *ParseSelfLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var selfLink string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &selfLink))
+
+	if resp.Error != nil {
+		return
+	}
+
+	matches := computeSelfLinkPattern.FindStringSubmatch(selfLink)
+	if matches == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not the self_link or ID of a compute resource.", selfLink))
+		return
+	}
+
+	// The ID has the format projects/{{project}}/global/{{type}}/{{name}}, or projects/{{project}}/regions/{{region}}/...
+	// and projects/{{project}}/zones/{{zone}}/... for the regional and zonal resources.
+	components := strings.Split(matches[1], "/")
+
+	region, zone := types.StringNull(), types.StringNull()
+
+	switch components[2] {
+	case "regions":
+		region = types.StringValue(components[3])
+	case "zones":
+		zone = types.StringValue(components[3])
+	}
+
+	result, diags := types.ObjectValue(selfLinkAttributeTypes, map[string]attr.Value{
+		"name":    types.StringValue(components[len(components)-1]),
+		"project": types.StringValue(components[1]),
+		"region":  region,
+		"scope":   types.StringValue(strings.Join(components[2:len(components)-2], "/")),
+		"type":    types.StringValue(components[len(components)-2]),
+		"zone":    zone,
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccParseSelfLinkFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					locals {
+						neg = provider::gkegateway::parse_self_link("https://www.googleapis.com/compute/v1/projects/my-gcp-project/zones/us-central1-a/networkEndpointGroups/my-neg")
+					}
+
+					output "name" {
+						value = local.neg.name
+					}
+
+					output "scope" {
+						value = local.neg.scope
+					}
+
+					output "type" {
+						value = local.neg.type
+					}
+
+					output "zone" {
+						value = local.neg.zone
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("name", "my-neg"),
+					resource.TestCheckOutput("scope", "zones/us-central1-a"),
+					resource.TestCheckOutput("type", "networkEndpointGroups"),
+					resource.TestCheckOutput("zone", "us-central1-a"),
+				),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::parse_self_link("projects/my-gcp-project/global/urlMaps/my-url-map").scope
+					}
+				`,
+				Check: resource.TestCheckOutput("test", "global"),
+			},
+			// invalid self_link
+			{
+				Config: `
+					output "test" {
+						value = provider::gkegateway::parse_self_link("my-url-map")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`is not the self_link or ID of a compute resource`),
+			},
+		},
+	})
+}
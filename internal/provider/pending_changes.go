@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pendingChangeAttrTypes are the attribute types of a pending change object.
+var pendingChangeAttrTypes = map[string]attr.Type{
+	"current": types.StringType,
+	"field":   types.StringType,
+	"planned": types.StringType,
+}
+
+// pendingChange is a field of a controller-owned resource that a patch resource changes.
+type pendingChange struct {
+	Current types.String `tfsdk:"current"`
+	Field   types.String `tfsdk:"field"`
+	Planned types.String `tfsdk:"planned"`
+}
+
+// pendingChangesAttribute is the schema of the pending_changes attribute shared by the patch resources.
+func pendingChangesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"current": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The live value of the field - will be null if it isn't set.",
+				},
+				"field": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The field of the live resource that changes.",
+				},
+				"planned": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The value the field is set to.",
+				},
+			},
+		},
+		MarkdownDescription: "The fields of the live resource the patch changes, computed at plan time from the live resource so reviewers can see exactly what will be mutated. After an apply, it holds the changes that apply made until a new patch is planned. Will be unknown when the plan has unknown values or the load balancer doesn't exist yet.",
+	}
+}
+
+// pendingChangesValue converts the changes into the pending_changes attribute value.
+func pendingChangesValue(ctx context.Context, changes []pendingChange) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pendingChangeAttrTypes}, changes)
+}
+
+// planPendingChanges returns the pending_changes to plan. When nothing changes, the changes of the last apply are kept
+// so a clean plan stays empty.
+func planPendingChanges(ctx context.Context, changes []pendingChange, state types.List) (types.List, diag.Diagnostics) {
+	if len(changes) == 0 && !state.IsNull() && !state.IsUnknown() {
+		return state, nil
+	}
+
+	return pendingChangesValue(ctx, changes)
+}
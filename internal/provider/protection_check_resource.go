@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProtectionCheckResource{}
+
+func NewProtectionCheckResource() resource.Resource {
+	return &ProtectionCheckResource{}
+}
+
+// ProtectionCheckResource defines the resource implementation.
+type ProtectionCheckResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// ProtectionCheckResourceModel describes the resource data model.
+type ProtectionCheckResourceModel struct {
+	Gateway              types.String  `tfsdk:"gateway"`
+	ID                   types.String  `tfsdk:"id"`
+	Lookback             types.String  `tfsdk:"lookback"`
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	Namespace            types.String  `tfsdk:"namespace"`
+	Project              types.String  `tfsdk:"project"`
+	Region               types.String  `tfsdk:"region"`
+}
+
+func (r *ProtectionCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *ProtectionCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data ProtectionCheckResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Surface a bad lookback now rather than when it's needed during a destroy.
+	_, diags := parseMonitoringLookback(data.Lookback)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Namespace.ValueString(), data.Gateway.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProtectionCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data ProtectionCheckResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := r.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRule, diags := r.providerData.findGatewayForwardingRule(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	// Nothing is serving traffic once the load balancer is gone.
+	if resp.Diagnostics.HasError() || forwardingRule == nil {
+		return
+	}
+
+	lookback, diags := parseMonitoringLookback(data.Lookback)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestsPerSecond, diags := r.providerData.forwardingRuleRequestRate(ctx, project, region, forwardingRule, lookback)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if requestsPerSecond > data.MaxRequestsPerSecond.ValueFloat64() {
+		resp.Diagnostics.AddError(
+			"Gateway is still serving traffic",
+			fmt.Sprintf("The %s forwarding rule for gateway %s/%s averaged %.2f requests per second over the last %s, above the allowed %.2f. Drain the gateway before removing its attachments or raise max_requests_per_second.", forwardingRule.GetName(), data.Namespace.ValueString(), data.Gateway.ValueString(), requestsPerSecond, lookback, data.MaxRequestsPerSecond.ValueFloat64()),
+		)
+	}
+}
+
+func (r *ProtectionCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_protection_check"
+}
+
+func (r *ProtectionCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data ProtectionCheckResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no remote object, the check only acts on destroy.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProtectionCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier for the check with format `{{namespace}}/{{gateway}}`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lookback": schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString("1h"),
+				MarkdownDescription: "How far back to average the request rate of the gateway, as a duration such as `30m`. Defaults to `1h`.",
+				Optional:            true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Computed:            true,
+				Default:             float64default.StaticFloat64(1),
+				MarkdownDescription: "The highest average request rate at which the gateway is considered idle and the destroy may proceed. Defaults to `1`.",
+				Optional:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+		},
+		MarkdownDescription: "Guards the decommissioning of a Kubernetes Gateway resource. Destroying this resource fails while the gateway's load balancer is still serving more traffic than allowed, as reported by Cloud Monitoring. Make the resource depend on the Terraform-managed attachments of the gateway (Cloud Armor policies, IAP settings, DNS records, ...) so it is destroyed, and therefore checked, before any of them are removed.",
+	}
+}
+
+func (r *ProtectionCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data ProtectionCheckResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := parseMonitoringLookback(data.Lookback)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// forwardingRuleRequestRate returns the average number of requests per second the forwarding rule received over the
+// lookback window.
+func (p *GKEGatewayProviderData) forwardingRuleRequestRate(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule, lookback time.Duration) (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceType, metricType := loadBalancerMetric(region, forwardingRule, "request_count")
+
+	end := time.Now().UTC()
+
+	timeSeries, err := p.monitoringService.Projects.TimeSeries.List(fmt.Sprintf("projects/%s", project)).
+		Filter(fmt.Sprintf(`metric.type = "%s" AND resource.type = "%s" AND resource.labels.forwarding_rule_name = "%s"`, metricType, resourceType, forwardingRule.GetName())).
+		IntervalStartTime(end.Add(-lookback).Format(time.RFC3339)).
+		IntervalEndTime(end.Format(time.RFC3339)).
+		AggregationAlignmentPeriod(fmt.Sprintf("%ds", int64(lookback.Seconds()))).
+		AggregationPerSeriesAligner("ALIGN_RATE").
+		AggregationCrossSeriesReducer("REDUCE_SUM").
+		Context(ctx).
+		Do()
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up the request rate of forwarding rule %s", forwardingRule.GetName()), fmt.Sprintf("Error calling Google API: %+v", err))
+		return 0, diags
+	}
+
+	var requestsPerSecond float64
+	for _, series := range timeSeries.TimeSeries {
+		for _, point := range series.Points {
+			if point.Value != nil && point.Value.DoubleValue != nil && *point.Value.DoubleValue > requestsPerSecond {
+				requestsPerSecond = *point.Value.DoubleValue
+			}
+		}
+	}
+
+	return requestsPerSecond, diags
+}
@@ -6,17 +6,40 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
 
 	compute "cloud.google.com/go/compute/apiv1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/certificatemanager/v1"
+	"google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
 )
 
 // Ensure GKEGatewayProvider satisfies various provider interfaces.
-var _ provider.Provider = &GKEGatewayProvider{}
+var (
+	_ provider.Provider                       = &GKEGatewayProvider{}
+	_ provider.ProviderWithConfigValidators   = &GKEGatewayProvider{}
+	_ provider.ProviderWithEphemeralResources = &GKEGatewayProvider{}
+	_ provider.ProviderWithFunctions          = &GKEGatewayProvider{}
+	_ provider.ProviderWithListResources      = &GKEGatewayProvider{}
+	_ provider.ProviderWithMetaSchema         = &GKEGatewayProvider{}
+	_ provider.ProviderWithValidateConfig     = &GKEGatewayProvider{}
+)
 
 // GKEGatewayProvider defines the provider implementation.
 type GKEGatewayProvider struct {
@@ -27,22 +50,67 @@ type GKEGatewayProvider struct {
 }
 
 type GKEGatewayProviderData struct {
+	addressesClient                *compute.AddressesClient
 	backendServicesClient          *compute.BackendServicesClient
+	certificateManagerService      *certificatemanager.Service
+	descriptionKey                 string
+	diskCache                      *diskCache
+	forwardingRulesCache           *forwardingRulesCache
 	forwardingRulesClient          *compute.ForwardingRulesClient
+	globalAddressesClient          *compute.GlobalAddressesClient
 	globalForwardingRulesClient    *compute.GlobalForwardingRulesClient
+	labelMatching                  *labelMatching
+	maxResults                     uint32
+	metrics                        *usageMetrics
+	monitoringService              *monitoring.Service
+	networkEndpointGroupsClient    *compute.NetworkEndpointGroupsClient
 	project                        types.String
 	region                         types.String
 	regionBackendServicesClient    *compute.RegionBackendServicesClient
+	regionSecurityPoliciesClient   *compute.RegionSecurityPoliciesClient
+	regionSslPoliciesClient        *compute.RegionSslPoliciesClient
+	regionTargetHttpProxiesClient  *compute.RegionTargetHttpProxiesClient
 	regionTargetHttpsProxiesClient *compute.RegionTargetHttpsProxiesClient
+	regionTargetTcpProxiesClient   *compute.RegionTargetTcpProxiesClient
 	regionUrlMapsClient            *compute.RegionUrlMapsClient
+	returnPartialSuccess           bool
+	securityPoliciesClient         *compute.SecurityPoliciesClient
+	serviceAttachmentsClient       *compute.ServiceAttachmentsClient
+	sslPoliciesClient              *compute.SslPoliciesClient
+	targetGrpcProxiesClient        *compute.TargetGrpcProxiesClient
+	targetHttpProxiesClient        *compute.TargetHttpProxiesClient
 	targetHttpsProxiesClient       *compute.TargetHttpsProxiesClient
+	targetSslProxiesClient         *compute.TargetSslProxiesClient
+	targetTcpProxiesClient         *compute.TargetTcpProxiesClient
 	urlMapsClient                  *compute.UrlMapsClient
+	userAgent                      string
 }
 
 // GKEGatewayProviderModel describes the provider data model.
 type GKEGatewayProviderModel struct {
-	Project types.String `tfsdk:"project"`
-	Region  types.String `tfsdk:"region"`
+	AccessToken                        types.String                                `tfsdk:"access_token"`
+	BillingProject                     types.String                                `tfsdk:"billing_project"`
+	CABundle                           types.String                                `tfsdk:"ca_bundle"`
+	Cache                              *GKEGatewayProviderModelCache               `tfsdk:"cache"`
+	ComputeCustomEndpoint              types.String                                `tfsdk:"compute_custom_endpoint"`
+	Credentials                        types.String                                `tfsdk:"credentials"`
+	DebugLogging                       types.Bool                                  `tfsdk:"debug_logging"`
+	DescriptionKey                     types.String                                `tfsdk:"description_key"`
+	ExternalCredentials                *GKEGatewayProviderModelExternalCredentials `tfsdk:"external_credentials"`
+	ImpersonateServiceAccount          types.String                                `tfsdk:"impersonate_service_account"`
+	ImpersonateServiceAccountDelegates types.List                                  `tfsdk:"impersonate_service_account_delegates"`
+	LabelMatching                      *GKEGatewayProviderModelLabelMatching       `tfsdk:"label_matching"`
+	MaxResults                         types.Int64                                 `tfsdk:"max_results"`
+	MetricsFile                        types.String                                `tfsdk:"metrics_file"`
+	Project                            types.String                                `tfsdk:"project"`
+	Region                             types.String                                `tfsdk:"region"`
+	RequestTimeout                     types.String                                `tfsdk:"request_timeout"`
+	Retries                            *GKEGatewayProviderModelRetries             `tfsdk:"retries"`
+	ReturnPartialSuccess               types.Bool                                  `tfsdk:"return_partial_success"`
+	Scopes                             types.List                                  `tfsdk:"scopes"`
+	UniverseDomain                     types.String                                `tfsdk:"universe_domain"`
+	UserAgentExtension                 types.String                                `tfsdk:"user_agent_extension"`
+	UserProjectOverride                types.Bool                                  `tfsdk:"user_project_override"`
 }
 
 func New(version string) func() provider.Provider {
@@ -56,12 +124,75 @@ func New(version string) func() provider.Provider {
 func (p *GKEGatewayProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data GKEGatewayProviderModel
 
+	// Settings coming from resources of the same plan, such as the project of a google_project, are only known during
+	// the apply, so Terraform defers the data sources and resources of the provider until then when it supports it.
+	if !req.Config.Raw.IsFullyKnown() && req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+
+		return
+	}
+
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	applyEnvironmentDefaults(&data)
+
+	if data.DebugLogging.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown debug_logging", "The debug_logging field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.DescriptionKey.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown description_key", "The description_key field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	descriptionKey := "k8sResource"
+	if !data.DescriptionKey.IsNull() {
+		descriptionKey = data.DescriptionKey.ValueString()
+	}
+
+	// Forwarding rules are matched by their description unless labels are set up too.
+	var gatewayLabelMatching *labelMatching
+
+	if data.LabelMatching != nil {
+		if data.LabelMatching.GatewayKey.IsUnknown() || data.LabelMatching.NamespaceKey.IsUnknown() || !mapKnown(data.LabelMatching.Labels) {
+			resp.Diagnostics.AddError("Unknown label_matching", "The label_matching block on the provider cannot be set to unknown values")
+			return
+		}
+
+		labels := map[string]string{}
+		resp.Diagnostics.Append(data.LabelMatching.Labels.ElementsAs(ctx, &labels, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		gatewayLabelMatching = &labelMatching{
+			gatewayKey:   data.LabelMatching.GatewayKey.ValueString(),
+			labels:       labels,
+			namespaceKey: data.LabelMatching.NamespaceKey.ValueString(),
+		}
+	}
+
+	if data.MetricsFile.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown metrics_file", "The metrics_file field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	// Metrics are only recorded when explicitly asked for.
+	var metrics *usageMetrics
+	if !data.MetricsFile.IsNull() {
+		metrics = &usageMetrics{
+			path: data.MetricsFile.ValueString(),
+		}
+	}
+
 	if data.Project.IsUnknown() {
 		resp.Diagnostics.AddError("Unknown project", "The project field on the provider cannot be set to an unknown value")
 		return
@@ -72,73 +203,459 @@ func (p *GKEGatewayProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	backendServicesClient, err := compute.NewBackendServicesRESTClient(ctx)
+	if data.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown access_token", "The access_token field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.BillingProject.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown billing_project", "The billing_project field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.CABundle.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown ca_bundle", "The ca_bundle field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.ComputeCustomEndpoint.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown compute_custom_endpoint", "The compute_custom_endpoint field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.Credentials.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown credentials", "The credentials field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.ExternalCredentials != nil && !externalCredentialsKnown(data.ExternalCredentials) {
+		resp.Diagnostics.AddError("Unknown external_credentials", "The external_credentials block on the provider cannot be set to unknown values")
+		return
+	}
+
+	if data.ImpersonateServiceAccount.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown impersonate_service_account", "The impersonate_service_account field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if !listKnown(data.ImpersonateServiceAccountDelegates) {
+		resp.Diagnostics.AddError("Unknown impersonate_service_account_delegates", "The impersonate_service_account_delegates field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown request_timeout", "The request_timeout field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.MaxResults.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown max_results", "The max_results field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.ReturnPartialSuccess.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown return_partial_success", "The return_partial_success field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	// Responses are only kept on disk when explicitly asked for.
+	var responseCache *diskCache
+
+	if data.Cache != nil {
+		if data.Cache.Path.IsUnknown() || data.Cache.TTL.IsUnknown() {
+			resp.Diagnostics.AddError("Unknown cache", "The cache block on the provider cannot be set to unknown values")
+			return
+		}
+
+		ttl, diags := parseCacheTTL(data.Cache.TTL)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		responseCache = &diskCache{
+			path: data.Cache.Path.ValueString(),
+			ttl:  ttl,
+		}
+	}
+
+	// Rate limits and transient errors are retried even without a retries block, with its defaults.
+	retries := &GKEGatewayProviderModelRetries{}
+
+	if data.Retries != nil {
+		if data.Retries.InitialBackoff.IsUnknown() || data.Retries.MaxAttempts.IsUnknown() || data.Retries.MaxBackoff.IsUnknown() {
+			resp.Diagnostics.AddError("Unknown retries", "The retries block on the provider cannot be set to unknown values")
+			return
+		}
+
+		retries = data.Retries
+	}
+
+	readRetryOption, mutationRetryOption, diags := retryCallOptions(retries)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !listKnown(data.Scopes) {
+		resp.Diagnostics.AddError("Unknown scopes", "The scopes field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.UniverseDomain.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown universe_domain", "The universe_domain field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.UserAgentExtension.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown user_agent_extension", "The user_agent_extension field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	if data.UserProjectOverride.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown user_project_override", "The user_project_override field on the provider cannot be set to an unknown value")
+		return
+	}
+
+	var transport *http.Transport
+	if !data.CABundle.IsNull() {
+		var err error
+
+		transport, err = caBundleTransport(data.CABundle.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error reading ca_bundle: %+v", err))
+			return
+		}
+	}
+
+	clientOptions, diags := googleClientOptions(ctx, data, transport)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computeClientOptions := clientOptions
+	if !data.ComputeCustomEndpoint.IsNull() {
+		computeClientOptions = append(slices.Clip(clientOptions), option.WithEndpoint(data.ComputeCustomEndpoint.ValueString()))
+	}
+
+	addressesClient, err := compute.NewAddressesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Addresses client: %+v", err))
+		return
+	}
+
+	backendServicesClient, err := compute.NewBackendServicesRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google BackendServices : %+v", err))
 		return
 	}
 
-	forwardingRulesClient, err := compute.NewForwardingRulesRESTClient(ctx)
+	certificateManagerService, err := certificatemanager.NewService(ctx, clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Certificate Manager client: %+v", err))
+		return
+	}
+
+	forwardingRulesClient, err := compute.NewForwardingRulesRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Forwarding Rules client: %+v", err))
 		return
 	}
 
-	globalForwardingRulesClient, err := compute.NewGlobalForwardingRulesRESTClient(ctx)
+	globalAddressesClient, err := compute.NewGlobalAddressesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Global Addresses client: %+v", err))
+		return
+	}
+
+	globalForwardingRulesClient, err := compute.NewGlobalForwardingRulesRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Global Forwarding Rules client: %+v", err))
 		return
 	}
 
-	regionBackendServicesClient, err := compute.NewRegionBackendServicesRESTClient(ctx)
+	monitoringService, err := monitoring.NewService(ctx, clientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Cloud Monitoring client: %+v", err))
+		return
+	}
+
+	networkEndpointGroupsClient, err := compute.NewNetworkEndpointGroupsRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Network Endpoint Groups client: %+v", err))
+		return
+	}
+
+	regionBackendServicesClient, err := compute.NewRegionBackendServicesRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional Backend Services client: %+v", err))
 		return
 	}
 
-	regionTargetHttpsProxiesClient, err := compute.NewRegionTargetHttpsProxiesRESTClient(ctx)
+	regionSecurityPoliciesClient, err := compute.NewRegionSecurityPoliciesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional Security Policies client: %+v", err))
+		return
+	}
+
+	regionSslPoliciesClient, err := compute.NewRegionSslPoliciesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional SSL Policies client: %+v", err))
+		return
+	}
+
+	regionTargetHttpProxiesClient, err := compute.NewRegionTargetHttpProxiesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional Target HTTP Proxies client: %+v", err))
+		return
+	}
+
+	regionTargetHttpsProxiesClient, err := compute.NewRegionTargetHttpsProxiesRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional Target HTTPS Proxies client: %+v", err))
 		return
 	}
 
-	regionUrlMapsClient, err := compute.NewRegionUrlMapsRESTClient(ctx)
+	regionTargetTcpProxiesClient, err := compute.NewRegionTargetTcpProxiesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional Target TCP Proxies client: %+v", err))
+		return
+	}
+
+	regionUrlMapsClient, err := compute.NewRegionUrlMapsRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Regional URL Maps client: %+v", err))
 		return
 	}
 
-	targetHttpsProxiesClient, err := compute.NewTargetHttpsProxiesRESTClient(ctx)
+	securityPoliciesClient, err := compute.NewSecurityPoliciesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Security Policies client: %+v", err))
+		return
+	}
+
+	serviceAttachmentsClient, err := compute.NewServiceAttachmentsRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Service Attachments client: %+v", err))
+		return
+	}
+
+	sslPoliciesClient, err := compute.NewSslPoliciesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google SSL Policies client: %+v", err))
+		return
+	}
+
+	targetGrpcProxiesClient, err := compute.NewTargetGrpcProxiesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Target gRPC Proxies client: %+v", err))
+		return
+	}
+
+	targetHttpProxiesClient, err := compute.NewTargetHttpProxiesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Target HTTP Proxies client: %+v", err))
+		return
+	}
+
+	targetHttpsProxiesClient, err := compute.NewTargetHttpsProxiesRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Target HTTPS Proxies client: %+v", err))
 		return
 	}
 
-	urlMapsClient, err := compute.NewUrlMapsRESTClient(ctx)
+	targetSslProxiesClient, err := compute.NewTargetSslProxiesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Target SSL Proxies client: %+v", err))
+		return
+	}
+
+	targetTcpProxiesClient, err := compute.NewTargetTcpProxiesRESTClient(ctx, computeClientOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google Target TCP Proxies client: %+v", err))
+		return
+	}
+
+	urlMapsClient, err := compute.NewUrlMapsRESTClient(ctx, computeClientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure provider", fmt.Sprintf("Error setting up Google URL Maps client: %+v", err))
 		return
 	}
 
+	userAgent := providerUserAgent(req.TerraformVersion, p.version, data.UserAgentExtension)
+
+	// The compute clients get the User-Agent from the context of each call, which can carry the module attribution.
+	certificateManagerService.UserAgent = userAgent
+	monitoringService.UserAgent = userAgent
+
+	// Only the compute clients go through gax, the other APIs keep the retries of their client libraries.
+	for _, callOptions := range []any{
+		addressesClient.CallOptions,
+		backendServicesClient.CallOptions,
+		forwardingRulesClient.CallOptions,
+		globalAddressesClient.CallOptions,
+		globalForwardingRulesClient.CallOptions,
+		networkEndpointGroupsClient.CallOptions,
+		regionBackendServicesClient.CallOptions,
+		regionSecurityPoliciesClient.CallOptions,
+		regionSslPoliciesClient.CallOptions,
+		regionTargetHttpProxiesClient.CallOptions,
+		regionTargetHttpsProxiesClient.CallOptions,
+		regionTargetTcpProxiesClient.CallOptions,
+		regionUrlMapsClient.CallOptions,
+		securityPoliciesClient.CallOptions,
+		serviceAttachmentsClient.CallOptions,
+		sslPoliciesClient.CallOptions,
+		targetGrpcProxiesClient.CallOptions,
+		targetHttpProxiesClient.CallOptions,
+		targetHttpsProxiesClient.CallOptions,
+		targetSslProxiesClient.CallOptions,
+		targetTcpProxiesClient.CallOptions,
+		urlMapsClient.CallOptions,
+	} {
+		appendRetryCallOptions(callOptions, readRetryOption, mutationRetryOption)
+	}
+
 	providerData := &GKEGatewayProviderData{
+		addressesClient:                addressesClient,
 		backendServicesClient:          backendServicesClient,
+		certificateManagerService:      certificateManagerService,
+		descriptionKey:                 descriptionKey,
+		diskCache:                      responseCache,
+		forwardingRulesCache:           &forwardingRulesCache{entries: map[string]*forwardingRulesCacheEntry{}},
 		forwardingRulesClient:          forwardingRulesClient,
+		globalAddressesClient:          globalAddressesClient,
 		globalForwardingRulesClient:    globalForwardingRulesClient,
+		labelMatching:                  gatewayLabelMatching,
+		maxResults:                     uint32(data.MaxResults.ValueInt64()),
+		metrics:                        metrics,
+		monitoringService:              monitoringService,
+		networkEndpointGroupsClient:    networkEndpointGroupsClient,
 		project:                        data.Project,
 		region:                         data.Region,
 		regionBackendServicesClient:    regionBackendServicesClient,
+		regionSecurityPoliciesClient:   regionSecurityPoliciesClient,
+		regionSslPoliciesClient:        regionSslPoliciesClient,
+		regionTargetHttpProxiesClient:  regionTargetHttpProxiesClient,
 		regionTargetHttpsProxiesClient: regionTargetHttpsProxiesClient,
+		regionTargetTcpProxiesClient:   regionTargetTcpProxiesClient,
 		regionUrlMapsClient:            regionUrlMapsClient,
+		returnPartialSuccess:           data.ReturnPartialSuccess.ValueBool(),
+		securityPoliciesClient:         securityPoliciesClient,
+		serviceAttachmentsClient:       serviceAttachmentsClient,
+		sslPoliciesClient:              sslPoliciesClient,
+		targetGrpcProxiesClient:        targetGrpcProxiesClient,
+		targetHttpProxiesClient:        targetHttpProxiesClient,
 		targetHttpsProxiesClient:       targetHttpsProxiesClient,
+		targetSslProxiesClient:         targetSslProxiesClient,
+		targetTcpProxiesClient:         targetTcpProxiesClient,
 		urlMapsClient:                  urlMapsClient,
+		userAgent:                      userAgent,
 	}
 	resp.DataSourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ListResourceData = providerData
 	resp.ResourceData = providerData
 }
 
+// ConfigValidators rejects the combinations of settings that can't work together, and the malformed defaults of the
+// environment variables, when validating the configuration rather than when configuring the provider.
+func (p *GKEGatewayProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providerConfigValidator{
+			attributes:  []string{"access_token", "credentials"},
+			description: "Only one of access_token or credentials can be set.",
+			validate: func(data *GKEGatewayProviderModel, diags *diag.Diagnostics) {
+				if !data.AccessToken.IsNull() && !data.Credentials.IsNull() {
+					diags.AddAttributeError(path.Root("access_token"), "Invalid Attribute Combination", "Only one of access_token or credentials can be set.")
+				}
+			},
+		},
+		providerConfigValidator{
+			attributes:  []string{"access_token", "credentials", "external_credentials"},
+			description: "The external_credentials can't be set along with access_token or credentials.",
+			validate: func(data *GKEGatewayProviderModel, diags *diag.Diagnostics) {
+				if data.ExternalCredentials != nil && (!data.AccessToken.IsNull() || !data.Credentials.IsNull()) {
+					diags.AddAttributeError(path.Root("external_credentials"), "Invalid Attribute Combination", "The external_credentials can't be set along with access_token or credentials.")
+				}
+			},
+		},
+		providerConfigValidator{
+			attributes:  []string{"impersonate_service_account", "impersonate_service_account_delegates"},
+			description: "The impersonate_service_account_delegates can only be set along with impersonate_service_account.",
+			validate: func(data *GKEGatewayProviderModel, diags *diag.Diagnostics) {
+				if data.ImpersonateServiceAccount.IsNull() && !data.ImpersonateServiceAccountDelegates.IsNull() {
+					diags.AddAttributeError(path.Root("impersonate_service_account_delegates"), "Invalid Attribute Combination", "The impersonate_service_account_delegates can only be set along with impersonate_service_account.")
+				}
+			},
+		},
+		providerConfigValidator{
+			attributes:  []string{"access_token", "billing_project", "credentials", "external_credentials", "project", "region", "user_project_override"},
+			description: "Either billing_project or project must be set along with user_project_override.",
+			validate: func(data *GKEGatewayProviderModel, diags *diag.Diagnostics) {
+				applyEnvironmentDefaults(data)
+
+				if data.UserProjectOverride.ValueBool() && data.BillingProject.IsNull() && data.Project.IsNull() {
+					diags.AddAttributeError(path.Root("user_project_override"), "Missing billing project", "Either billing_project or project must be set along with user_project_override.")
+				}
+			},
+		},
+		providerConfigValidator{
+			attributes:  []string{"project", "region"},
+			description: "The project and region set by the environment variables must be well-formed.",
+			validate: func(data *GKEGatewayProviderModel, diags *diag.Diagnostics) {
+				// The values of the configuration are validated by the attributes themselves.
+				if data.Project.IsNull() {
+					validateEnvironmentDefault(path.Root("project"), projectIDValidator(), diags, "GOOGLE_PROJECT", "GOOGLE_CLOUD_PROJECT")
+				}
+
+				if data.Region.IsNull() {
+					validateEnvironmentDefault(path.Root("region"), regionValidator(), diags, "GOOGLE_REGION")
+				}
+			},
+		},
+	}
+}
+
 func (p *GKEGatewayProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAddressDataSource,
 		NewBackendServiceDataSource,
+		NewCertificateMapDataSource,
+		NewGatewayDataSource,
+		NewGatewaysDataSource,
+		NewNetworkEndpointGroupsDataSource,
+		NewOrphansDataSource,
+		NewRouteLatenciesDataSource,
+		NewSecurityPolicyDataSource,
+		NewServiceAttachmentDataSource,
+		NewSslPolicyDataSource,
+	}
+}
+
+func (p *GKEGatewayProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewBackendServiceEphemeralResource,
+	}
+}
+
+func (p *GKEGatewayProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBetaSelfLinkFunction,
+		NewK8sResourceDescriptionFunction,
+		NewNegNameFunction,
+		NewParseBackendServiceIDFunction,
+		NewParseSelfLinkFunction,
+	}
+}
+
+func (p *GKEGatewayProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewBackendServicePatchListResource,
 	}
 }
 
@@ -147,22 +664,322 @@ func (p *GKEGatewayProvider) Metadata(ctx context.Context, req provider.Metadata
 	resp.Version = p.version
 }
 
+func (p *GKEGatewayProvider) MetaSchema(ctx context.Context, req provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+	resp.Schema = metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"module_name": metaschema.StringAttribute{
+				MarkdownDescription: "The name of the module using the provider, appended to the User-Agent of its API calls.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
 func (p *GKEGatewayProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewBackendServicePatchResource,
+		NewCanaryWeightsResource,
+		NewNegEndpointResource,
+		NewProtectionCheckResource,
+		NewWaitForBackendResource,
+	}
 }
 
 func (p *GKEGatewayProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "A temporary OAuth 2.0 access token, such as one minted by the `google_service_account_access_token` data source or Vault. It is not refreshed, so it must be valid for the whole Terraform run. Conflicts with `credentials`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"billing_project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project quota and billing are charged to when `user_project_override` is set, such as when the credentials come from another organization. If it is not provided, the provider project is used.",
+				Optional:            true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "Either the path to or the contents of a bundle of PEM certificates to trust on top of the system ones, such as the certificate authority of an egress proxy doing TLS inspection. The proxy itself is read from the `HTTPS_PROXY` and `NO_PROXY` environment variables. The certificates are only trusted by this configuration of the provider, and can't be used along with the client certificates of the mTLS endpoints.",
+				Optional:            true,
+			},
+			"compute_custom_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Compute Engine API, such as a Private Service Connect endpoint like `https://compute-myendpoint.p.googleapis.com` or a local emulator. If it is not provided, `https://compute.googleapis.com` is used.",
+				Optional:            true,
+			},
+			"credentials": schema.StringAttribute{
+				MarkdownDescription: "Either the path to or the contents of a service account key file in JSON format. Defaults to the `GOOGLE_CREDENTIALS` environment variable. If neither it nor `access_token` are provided, Application Default Credentials are used.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"debug_logging": schema.BoolAttribute{
+				MarkdownDescription: "Whether to log the method, URL, status and latency of each request to the Google APIs at the `DEBUG` level, such as with `TF_LOG_PROVIDER=DEBUG`, to diagnose why a lookup finds nothing. Defaults to `false`.",
+				Optional:            true,
+			},
+			"description_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the forwarding rule JSON description holding the path of the Kubernetes gateway, such as `/namespaces/{{namespace}}/gateways/{{name}}`. Defaults to `k8sResource`, as written by GKE, and is only needed for controllers using another layout.",
+				Optional:            true,
+			},
+			"impersonate_service_account": schema.StringAttribute{
+				MarkdownDescription: "The email of a service account to impersonate, such as a dedicated read-only service account. The provider credentials must be granted `roles/iam.serviceAccountTokenCreator` on it.",
+				Optional:            true,
+			},
+			"impersonate_service_account_delegates": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The emails of the service accounts in the delegation chain, when `impersonate_service_account` can't be impersonated directly. Each must be granted `roles/iam.serviceAccountTokenCreator` on the next one.",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "How many forwarding rules each page of the forwarding rule listings holds, between `1` and `500`. Smaller pages keep each request short in projects with many rules, at the cost of more requests. If it is not provided, the API default of `500` is used.",
+				Optional:            true,
+			},
+			"metrics_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file to append anonymized usage metrics to, as JSON lines, for analyzing the performance of the provider. Each line records the kind of operation, such as `forwarding_rule_scan`, when it started, how long it took and how many items it found, without any project or resource names. Cache lookups are recorded as `_hit` or `_miss` events of their cache, such as `forwarding_rule_memory_cache_hit` or `url_map_disk_cache_miss`, for computing the hit rates. Nothing is recorded, or sent anywhere, unless this is set.",
+				Optional:            true,
+			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The ID of the project in which the resources belong. If another project is specified on the data block, it will take precedence.",
+				MarkdownDescription: "The ID of the project in which the resources belong. If another project is specified on the data block, it will take precedence. Defaults to the `GOOGLE_PROJECT` or `GOOGLE_CLOUD_PROJECT` environment variables.",
 				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
 			},
 			"region": schema.StringAttribute{
-				MarkdownDescription: "The region in which the resources belong. If another region is specified on the data block, it will take precedence. When not provided, the resources are presumed to be global.",
+				MarkdownDescription: "The region in which the resources belong. If another region is specified on the data block, it will take precedence. Defaults to the `GOOGLE_REGION` environment variable. When not provided, the resources are presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long each request to the Google APIs may take before it is abandoned, as a duration such as `30s`, so a slow API can't hang a plan indefinitely. Operations that poll, such as waiting for a backend to become healthy, are made of many requests and keep their own timeouts. If it is not provided, requests have no deadline.",
+				Optional:            true,
+			},
+			"return_partial_success": schema.BoolAttribute{
+				MarkdownDescription: "Whether the forwarding rule listings return the rules that could be listed when part of the scope is unavailable, reporting the API warnings as Terraform warnings, instead of failing the plan. Defaults to `false`.",
+				Optional:            true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The OAuth 2.0 scopes requested for the Google API calls, such as `https://www.googleapis.com/auth/compute.readonly` in restricted environments. Defaults to `https://www.googleapis.com/auth/cloud-platform`. Has no effect along with `access_token`, whose scopes were set when it was minted.",
+				Optional:            true,
+			},
+			"universe_domain": schema.StringAttribute{
+				MarkdownDescription: "The universe the Google APIs are called in, such as the domain of a Trusted Partner Cloud. The credentials must belong to the same universe. Defaults to `googleapis.com`.",
+				Optional:            true,
+			},
+			"user_agent_extension": schema.StringAttribute{
+				MarkdownDescription: "A string appended to the User-Agent sent to the Google APIs, such as the name of a platform team, to attribute the API calls of the provider in billing and audit exports. Modules can also append their own name with the `module_name` attribute of `provider_meta`.",
+				Optional:            true,
+			},
+			"user_project_override": schema.BoolAttribute{
+				MarkdownDescription: "Whether to charge quota and billing to `billing_project`, or the provider project, through the `X-Goog-User-Project` header, rather than to the project of the credentials. The credentials need the `serviceusage.services.use` permission on that project. Defaults to `false`.",
 				Optional:            true,
 			},
 		},
-		MarkdownDescription: "The GKE Gateway provider is used to lookup GCP load balancing resources created by Kubernetes Gateway resources.",
+		Blocks: map[string]schema.Block{
+			"cache": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path of the local directory the responses are kept in, created if it doesn't exist. The files are named after a hash of the lookup, so the directory can be shared by several configurations.",
+						Optional:            true,
+					},
+					"ttl": schema.StringAttribute{
+						MarkdownDescription: "How long a cached response is used before the API is called again, as a duration such as `15m`. Defaults to `15m`.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "Keeps the forwarding rule listings and URL maps on disk between runs, for organizations with tens of thousands of compute resources where listing them dominates the plan. A gateway missing from a cached listing is still looked up live, but changes to its URL map only show up once the cached one expires. If it is not provided, nothing is cached between runs.",
+			},
+			"external_credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "The audience of the workload identity pool provider, with format `//iam.googleapis.com/projects/{{project_number}}/locations/global/workloadIdentityPools/{{pool}}/providers/{{provider}}`.",
+						Optional:            true,
+					},
+					"service_account_email": schema.StringAttribute{
+						MarkdownDescription: "The email of the service account the federated identity impersonates. If it is not provided, the federated identity is used directly and must be granted access to the resources.",
+						Optional:            true,
+					},
+					"subject_token_field_name": schema.StringAttribute{
+						MarkdownDescription: "The field holding the subject token when it is read from a JSON document, such as `value` for the GitHub Actions token endpoint. If it is not provided, the whole file or response is the token.",
+						Optional:            true,
+					},
+					"subject_token_file": schema.StringAttribute{
+						MarkdownDescription: "Path of the file the subject token is read from, such as a token mounted by the CI system. Exactly one of `subject_token_file` or `subject_token_url` must be set.",
+						Optional:            true,
+					},
+					"subject_token_type": schema.StringAttribute{
+						MarkdownDescription: "The type of the subject token. Defaults to `urn:ietf:params:oauth:token-type:jwt`, for OIDC tokens.",
+						Optional:            true,
+					},
+					"subject_token_url": schema.StringAttribute{
+						MarkdownDescription: "URL the subject token is fetched from, such as the `ACTIONS_ID_TOKEN_REQUEST_URL` of GitHub Actions along with an `audience` query parameter.",
+						Optional:            true,
+					},
+					"subject_token_url_headers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Headers sent when fetching the subject token from `subject_token_url`, such as the `Authorization` header of GitHub Actions.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+				MarkdownDescription: "Authenticates through workload identity federation, such as from GitHub Actions, without any long-lived key and regardless of the Application Default Credentials of the machine. Conflicts with `access_token` and `credentials`.",
+			},
+			"label_matching": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"gateway_key": schema.StringAttribute{
+						MarkdownDescription: "The key of the forwarding rule label holding the name of the Kubernetes gateway.",
+						Optional:            true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Other labels the forwarding rules of the gateways must have, such as the one naming the controller.",
+						Optional:            true,
+					},
+					"namespace_key": schema.StringAttribute{
+						MarkdownDescription: "The key of the forwarding rule label holding the namespace of the Kubernetes gateway.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "Matches the forwarding rules to their Kubernetes gateway by the labels newer controllers set on them, which are more resilient to format changes than the JSON description, still falling back to the description for the rules without them. As labels and descriptions can't be filtered on together, the forwarding rules are then listed in full rather than filtered by the API. If it is not provided, only the description is used.",
+			},
+			"retries": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"initial_backoff": schema.StringAttribute{
+						MarkdownDescription: "How long to wait before the first retry, as a duration such as `1s`. The wait doubles with each retry. Defaults to `1s`.",
+						Optional:            true,
+					},
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "How many times a call is attempted in total, including the first attempt. Defaults to `3`.",
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "The longest wait between two retries, as a duration such as `30s`. Defaults to `30s`.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "How the compute API calls that fail with a `429` or `5xx` status are retried, such as when a large plan runs into the rate limits. The calls changing resources, such as the patches of backend services, are only retried on a `429` status, as they may have been applied when failing otherwise. The waits are jittered, so concurrent lookups don't retry in lockstep. If it is not provided, calls are attempted 3 times with waits from `1s` to `30s`.",
+			},
+		},
+		MarkdownDescription: "The GKE Gateway provider is used to lookup GCP load balancing resources created by Kubernetes Gateway resources. Like the Google client libraries, it calls the mTLS endpoints of the Google APIs with the device certificate of the machine, as required by context-aware access, when the `GOOGLE_API_USE_CLIENT_CERTIFICATE` environment variable is set to `true`.",
+	}
+}
+
+func (p *GKEGatewayProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data GKEGatewayProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Cache != nil {
+		if data.Cache.Path.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("cache").AtName("path"), "Missing path", "The path of the cache must be set.")
+		}
+
+		if !data.Cache.TTL.IsUnknown() {
+			_, diags := parseCacheTTL(data.Cache.TTL)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
+	if data.ExternalCredentials != nil {
+		if data.ExternalCredentials.Audience.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("external_credentials").AtName("audience"), "Missing audience", "The audience of the external_credentials must be set.")
+		}
+
+		if data.ExternalCredentials.SubjectTokenFile.IsNull() == data.ExternalCredentials.SubjectTokenURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("external_credentials").AtName("subject_token_file"), "Invalid Attribute Combination", "Exactly one of subject_token_file or subject_token_url must be set.")
+		}
+
+		if data.ExternalCredentials.SubjectTokenURL.IsNull() && !data.ExternalCredentials.SubjectTokenURLHeaders.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("external_credentials").AtName("subject_token_url_headers"), "Invalid Attribute Combination", "The subject_token_url_headers can only be set along with subject_token_url.")
+		}
 	}
+
+	if data.LabelMatching != nil {
+		if data.LabelMatching.GatewayKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("label_matching").AtName("gateway_key"), "Missing gateway_key", "The gateway_key of the label_matching must be set.")
+		}
+
+		if data.LabelMatching.NamespaceKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("label_matching").AtName("namespace_key"), "Missing namespace_key", "The namespace_key of the label_matching must be set.")
+		}
+	}
+
+	if !data.ComputeCustomEndpoint.IsNull() && !data.ComputeCustomEndpoint.IsUnknown() {
+		if endpoint, err := url.Parse(data.ComputeCustomEndpoint.ValueString()); err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("compute_custom_endpoint"), "Invalid compute_custom_endpoint", "The compute_custom_endpoint must be an absolute URL such as `https://compute.googleapis.com`.")
+		}
+	}
+
+	if !data.MaxResults.IsNull() && !data.MaxResults.IsUnknown() && (data.MaxResults.ValueInt64() < 1 || data.MaxResults.ValueInt64() > 500) {
+		resp.Diagnostics.AddAttributeError(path.Root("max_results"), "Invalid max_results", "The max_results must be between 1 and 500.")
+	}
+
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		_, diags := parseRequestTimeout(data.RequestTimeout)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if data.Retries != nil && !data.Retries.InitialBackoff.IsUnknown() && !data.Retries.MaxAttempts.IsUnknown() && !data.Retries.MaxBackoff.IsUnknown() {
+		_, _, diags := retryCallOptions(data.Retries)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// applyEnvironmentDefaults falls back to the environment variables of the google provider for the attributes that
+// aren't set, so the same environment configures both.
+func applyEnvironmentDefaults(data *GKEGatewayProviderModel) {
+	// The credentials would conflict with the other credentials set on the provider.
+	if data.Credentials.IsNull() && data.AccessToken.IsNull() && data.ExternalCredentials == nil {
+		data.Credentials = environmentDefault("GOOGLE_CREDENTIALS")
+	}
+
+	if data.Project.IsNull() {
+		data.Project = environmentDefault("GOOGLE_PROJECT", "GOOGLE_CLOUD_PROJECT")
+	}
+
+	if data.Region.IsNull() {
+		data.Region = environmentDefault("GOOGLE_REGION")
+	}
+}
+
+// environmentDefault returns the value of the first environment variable that is set, or null when none are.
+func environmentDefault(names ...string) types.String {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return types.StringValue(value)
+		}
+	}
+
+	return types.StringNull()
+}
+
+// listKnown returns whether the list and all its elements are known.
+func listKnown(value types.List) bool {
+	return !value.IsUnknown() && !slices.ContainsFunc(value.Elements(), attr.Value.IsUnknown)
+}
+
+// mapKnown returns whether the map and all its values are known.
+func mapKnown(value types.Map) bool {
+	if value.IsUnknown() {
+		return false
+	}
+
+	for _, element := range value.Elements() {
+		if element.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// externalCredentialsKnown returns whether all the values of the external_credentials block are known.
+func externalCredentialsKnown(externalCredentials *GKEGatewayProviderModelExternalCredentials) bool {
+	return mapKnown(externalCredentials.SubjectTokenURLHeaders) &&
+		!externalCredentials.Audience.IsUnknown() &&
+		!externalCredentials.ServiceAccountEmail.IsUnknown() &&
+		!externalCredentials.SubjectTokenFieldName.IsUnknown() &&
+		!externalCredentials.SubjectTokenFile.IsUnknown() &&
+		!externalCredentials.SubjectTokenType.IsUnknown() &&
+		!externalCredentials.SubjectTokenURL.IsUnknown()
 }
@@ -4,10 +4,30 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -22,3 +42,529 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testConfigValue returns the raw value of a configuration of the schema type with the given attributes set, every other
+// attribute and block left null, for calling the validations directly with values only known at apply time.
+func testConfigValue(t *testing.T, schemaType attr.Type, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := schemaType.TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected an object schema type, got %s", schemaType)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	for name, value := range values {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("unexpected attribute %s", name)
+		}
+
+		attributes[name] = value
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// testAccComputeServer serves the given compute resources, keyed by their path under the compute API such as
+// `projects/my-gcp-project/global/backendServices/my-backend-service`, so the lookups can be tested against a stand-in
+// for the compute API set as compute_custom_endpoint. The collections list the resources directly in them, the missing
+// resources are not found, and every write completes at once.
+func testAccComputeServer(t *testing.T, resources map[string]proto.Message) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodGet {
+			fmt.Fprint(w, `{"name":"operation-test","status":"DONE"}`)
+			return
+		}
+
+		resourcePath := strings.TrimPrefix(r.URL.Path, "/compute/v1/")
+
+		if message, ok := resources[resourcePath]; ok {
+			content, _ := protojson.Marshal(message)
+			w.Write(content)
+			return
+		}
+
+		// Under projects/{{project}}/global or projects/{{project}}/regions/{{region}}, collections have a single
+		// component and resources two.
+		components := strings.Split(resourcePath, "/")
+		scoped := components[min(3, len(components)):]
+		if len(components) > 2 && components[2] != "global" {
+			scoped = components[min(4, len(components)):]
+		}
+
+		if len(scoped) != 1 {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"error":{"code":404,"message":"The resource '%s' was not found"}}`, resourcePath)
+			return
+		}
+
+		items := []json.RawMessage{}
+		for _, key := range slices.Sorted(maps.Keys(resources)) {
+			if path.Dir(key) == resourcePath {
+				content, _ := protojson.Marshal(resources[key])
+				items = append(items, content)
+			}
+		}
+
+		content, _ := json.Marshal(map[string]any{"items": items})
+		w.Write(content)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testAccGatewayComputeResources returns the global load balancer GKE creates for the my-cool-app/my-gateway-name
+// gateway in my-gcp-project, for testAccComputeServer.
+func testAccGatewayComputeResources() map[string]proto.Message {
+	const prefix = "https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/"
+
+	return map[string]proto.Message{
+		"projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd": &computepb.ForwardingRule{
+			Description: proto.String(`{"k8sResource":"/namespaces/my-cool-app/gateways/my-gateway-name"}`),
+			IPAddress:   proto.String("203.0.113.10"),
+			Name:        proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			PortRange:   proto.String("443-443"),
+			SelfLink:    proto.String(prefix + "forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			Target:      proto.String(prefix + "targetHttpsProxies/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+		},
+		"projects/my-gcp-project/global/targetHttpsProxies/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd": &computepb.TargetHttpsProxy{
+			Name:     proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			SelfLink: proto.String(prefix + "targetHttpsProxies/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			UrlMap:   proto.String(prefix + "urlMaps/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+		},
+		"projects/my-gcp-project/global/urlMaps/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd": &computepb.UrlMap{
+			DefaultService: proto.String(prefix + "backendServices/gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b"),
+			Name:           proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+			SelfLink:       proto.String(prefix + "urlMaps/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+		},
+		"projects/my-gcp-project/global/backendServices/gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b": &computepb.BackendService{
+			Description: proto.String(`{"kubernetes.io/service-name":"my-cool-app/my-service","kubernetes.io/service-port":"8080"}`),
+			Fingerprint: proto.String("fingerprint"),
+			Name:        proto.String("gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b"),
+			Protocol:    proto.String("HTTP"),
+			SelfLink:    proto.String(prefix + "backendServices/gkegw1-0a1b-my-cool-app-my-service-8080-4e5f6a7b"),
+		},
+	}
+}
+
+// testAccComputeProviderConfig returns the configuration of the provider using the stand-in compute API, with the
+// project defaulting to my-gcp-project.
+func testAccComputeProviderConfig(server *httptest.Server) string {
+	return fmt.Sprintf(`
+		provider "gkegateway" {
+			access_token            = "my-access-token"
+			compute_custom_endpoint = %q
+			project                 = "my-gcp-project"
+		}
+	`, server.URL)
+}
+
+// testComputeProviderData configures the provider against the stand-in compute API like testAccComputeProviderConfig,
+// with the given settings on top, so the lookups can be called directly without Terraform.
+func testComputeProviderData(t *testing.T, server *httptest.Server, values map[string]tftypes.Value) *GKEGatewayProviderData {
+	t.Helper()
+
+	values = maps.Clone(values)
+	if values == nil {
+		values = map[string]tftypes.Value{}
+	}
+
+	values["access_token"] = tftypes.NewValue(tftypes.String, "my-access-token")
+	values["compute_custom_endpoint"] = tftypes.NewValue(tftypes.String, server.URL)
+	values["project"] = tftypes.NewValue(tftypes.String, "my-gcp-project")
+
+	var resp provider.ConfigureResponse
+	New("test")().Configure(context.Background(), provider.ConfigureRequest{Config: testProviderConfig(t, values)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	return resp.ResourceData.(*GKEGatewayProviderData)
+}
+
+// TestProviderUnknownValues checks the settings only known at apply time pass the validations, and make configuring
+// the provider fail with an error saying so when the data sources and resources can't be deferred.
+func TestProviderUnknownValues(t *testing.T) {
+	tests := map[string]struct {
+		values        map[string]tftypes.Value
+		expectedError string
+	}{
+		"external_credentials": {
+			values: map[string]tftypes.Value{
+				"access_token": tftypes.NewValue(tftypes.String, nil),
+				"external_credentials": testProviderBlockValue(t, "external_credentials", map[string]tftypes.Value{
+					"audience":                  tftypes.NewValue(tftypes.String, "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/my-pool/providers/my-provider"),
+					"subject_token_url":         tftypes.NewValue(tftypes.String, "https://token.example.com"),
+					"subject_token_url_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				}),
+			},
+			expectedError: "The external_credentials block on the provider cannot be set to unknown values",
+		},
+		"impersonate_service_account_delegates": {
+			values: map[string]tftypes.Value{
+				"impersonate_service_account":           tftypes.NewValue(tftypes.String, "my-service-account@my-gcp-project.iam.gserviceaccount.com"),
+				"impersonate_service_account_delegates": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			},
+			expectedError: "The impersonate_service_account_delegates field on the provider cannot be set to an unknown value",
+		},
+		"label_matching": {
+			values: map[string]tftypes.Value{
+				"label_matching": testProviderBlockValue(t, "label_matching", map[string]tftypes.Value{
+					"gateway_key":   tftypes.NewValue(tftypes.String, "gateway"),
+					"labels":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+					"namespace_key": tftypes.NewValue(tftypes.String, "namespace"),
+				}),
+			},
+			expectedError: "The label_matching block on the provider cannot be set to unknown values",
+		},
+		"scopes": {
+			values: map[string]tftypes.Value{
+				"scopes": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			},
+			expectedError: "The scopes field on the provider cannot be set to an unknown value",
+		},
+		"scope": {
+			values: map[string]tftypes.Value{
+				"scopes": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			expectedError: "The scopes field on the provider cannot be set to an unknown value",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Configuring the provider doesn't fall back to Application Default Credentials with a token.
+			values := map[string]tftypes.Value{
+				"access_token": tftypes.NewValue(tftypes.String, "my-access-token"),
+			}
+			maps.Copy(values, test.values)
+
+			config := testProviderConfig(t, values)
+
+			if diags := testProviderValidate(config); diags.HasError() {
+				t.Fatalf("unexpected validation diagnostics: %v", diags)
+			}
+
+			var resp provider.ConfigureResponse
+			New("test")().Configure(context.Background(), provider.ConfigureRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Detail() != test.expectedError {
+				t.Fatalf("expected the error %q, got: %v", test.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestProviderImpersonateServiceAccountDelegatesConflict checks the delegates, known or not, need a service account to
+// impersonate.
+func TestProviderImpersonateServiceAccountDelegatesConflict(t *testing.T) {
+	tests := map[string]struct {
+		delegates     tftypes.Value
+		expectedError bool
+	}{
+		"known": {
+			delegates: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "my-delegate@my-gcp-project.iam.gserviceaccount.com"),
+			}),
+			expectedError: true,
+		},
+		"unknown": {
+			delegates:     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expectedError: true,
+		},
+		"null": {
+			delegates: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := testProviderValidate(testProviderConfig(t, map[string]tftypes.Value{
+				"impersonate_service_account_delegates": test.delegates,
+			}))
+
+			expected := "The impersonate_service_account_delegates can only be set along with impersonate_service_account."
+
+			if test.expectedError && (diags.ErrorsCount() != 1 || diags.Errors()[0].Detail() != expected) {
+				t.Fatalf("expected the error %q, got: %v", expected, diags)
+			}
+
+			if !test.expectedError && diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+// TestProviderConfigValidatorsUnknownValues checks the config validators only decode the settings they read, so unknown
+// values elsewhere, such as a block generated from a value only known at apply time, don't fail each of them.
+func TestProviderConfigValidatorsUnknownValues(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*GKEGatewayProvider)
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := testProviderConfig(t, map[string]tftypes.Value{
+		"cache":          tftypes.NewValue(schemaResp.Schema.Blocks["cache"].Type().TerraformType(ctx), tftypes.UnknownValue),
+		"label_matching": tftypes.NewValue(schemaResp.Schema.Blocks["label_matching"].Type().TerraformType(ctx), tftypes.UnknownValue),
+		"retries":        tftypes.NewValue(schemaResp.Schema.Blocks["retries"].Type().TerraformType(ctx), tftypes.UnknownValue),
+	})
+
+	known := map[string]bool{}
+	model := reflect.TypeOf(GKEGatewayProviderModel{})
+
+	for i := 0; i < model.NumField(); i++ {
+		known[model.Field(i).Tag.Get("tfsdk")] = true
+	}
+
+	for _, v := range p.ConfigValidators(ctx) {
+		for _, attribute := range v.(providerConfigValidator).attributes {
+			if !known[attribute] {
+				t.Errorf("%q reads %s, which is not a setting of the provider", v.Description(ctx), attribute)
+			}
+		}
+
+		var resp provider.ValidateConfigResponse
+		v.ValidateProvider(ctx, provider.ValidateConfigRequest{Config: config}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%q: unexpected diagnostics: %v", v.Description(ctx), resp.Diagnostics)
+		}
+	}
+}
+
+// testProviderConfig returns the configuration of the provider with the given attributes set.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp provider.SchemaResponse
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, &resp)
+
+	return tfsdk.Config{
+		Raw:    testConfigValue(t, resp.Schema.Type(), values),
+		Schema: resp.Schema,
+	}
+}
+
+// testProviderBlockValue returns the raw value of the given block of the provider configuration with the given
+// attributes set.
+func testProviderBlockValue(t *testing.T, block string, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	var resp provider.SchemaResponse
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, &resp)
+
+	return testConfigValue(t, resp.Schema.Blocks[block].Type(), values)
+}
+
+// testProviderValidate runs the validations of the provider configuration, along with its config validators, like
+// Terraform does.
+func testProviderValidate(config tfsdk.Config) diag.Diagnostics {
+	ctx := context.Background()
+	p := New("test")().(*GKEGatewayProvider)
+	req := provider.ValidateConfigRequest{Config: config}
+
+	var resp provider.ValidateConfigResponse
+	p.ValidateConfig(ctx, req, &resp)
+
+	for _, v := range p.ConfigValidators(ctx) {
+		v.ValidateProvider(ctx, req, &resp)
+	}
+
+	return resp.Diagnostics
+}
+
+func TestAccProviderValidations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// conflicting fields
+			{
+				Config: `
+					provider "gkegateway" {
+						access_token = "my-access-token"
+						credentials  = "my-key-file.json"
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Only one of access_token or credentials can be set.`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						credentials = "my-key-file.json"
+
+						external_credentials {
+							audience           = "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/my-pool/providers/my-provider"
+							subject_token_file = "/var/run/secrets/token"
+						}
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The external_credentials can't be set along with access_token or credentials.`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						external_credentials {
+							audience           = "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/my-pool/providers/my-provider"
+							subject_token_file = "/var/run/secrets/token"
+							subject_token_url  = "https://token.actions.githubusercontent.com"
+						}
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Exactly one of subject_token_file or subject_token_url must be set.`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						impersonate_service_account_delegates = ["delegate@my-gcp-project.iam.gserviceaccount.com"]
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The impersonate_service_account_delegates can only be set along with`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						user_project_override = true
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Either billing_project or project must be set along with`),
+			},
+			// invalid fields
+			{
+				Config: `
+					provider "gkegateway" {
+						compute_custom_endpoint = "compute.googleapis.com"
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The compute_custom_endpoint must be an absolute URL`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						request_timeout = "soon"
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The request_timeout must be a duration such as`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						cache {
+							path = "/tmp/gkegateway"
+							ttl  = "forever"
+						}
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The ttl must be a duration such as`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						max_results = 1000
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The max_results must be between 1 and 500.`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						label_matching {
+							namespace_key = "gateway-namespace"
+						}
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The gateway_key of the label_matching must be set.`),
+			},
+			{
+				Config: `
+					provider "gkegateway" {
+						retries {
+							initial_backoff = "10s"
+							max_backoff     = "5s"
+						}
+					}
+
+					data "gkegateway_gateway" "example" {
+						gateway   = "my-gateway-name"
+						namespace = "my-cool-app"
+						project   = "my-gcp-project"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The max_backoff must be at least the initial_backoff.`),
+			},
+		},
+	})
+}
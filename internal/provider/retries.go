@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/googleapis/gax-go/v2"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// retryableHTTPCodes are the statuses of the compute API calls reading resources that are worth retrying.
+var retryableHTTPCodes = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// retryableMutationHTTPCodes are the statuses of the compute API calls changing resources that are worth retrying. A
+// change failing with a 5xx status may still have been applied, and retrying it would then fail on the stale
+// fingerprint it sends or attach the endpoints twice, while a rate limited one was rejected before being applied.
+var retryableMutationHTTPCodes = map[int]bool{
+	http.StatusTooManyRequests: true,
+}
+
+// GKEGatewayProviderModelRetries describes the retries block of the provider.
+type GKEGatewayProviderModelRetries struct {
+	InitialBackoff types.String `tfsdk:"initial_backoff"`
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	MaxBackoff     types.String `tfsdk:"max_backoff"`
+}
+
+// retryer retries the failed calls with an exponential backoff, until they succeed or run out of attempts. The pauses
+// of gax are drawn at random up to the current backoff, so the concurrent lookups of a plan hitting the same rate limit
+// spread out their retries.
+type retryer struct {
+	attempts    int64
+	backoff     gax.Backoff
+	codes       map[int]bool
+	maxAttempts int64
+}
+
+func (r *retryer) Retry(err error) (time.Duration, bool) {
+	r.attempts++
+
+	if r.attempts >= r.maxAttempts {
+		return 0, false
+	}
+
+	var apiErr *apierror.APIError
+	if !errors.As(err, &apiErr) || !r.codes[apiErr.HTTPCode()] {
+		return 0, false
+	}
+
+	return r.backoff.Pause(), true
+}
+
+// retryCallOptions return the call options applying the retry policy to the calls reading and changing resources, with
+// the defaults of gax for what isn't set.
+func retryCallOptions(retries *GKEGatewayProviderModelRetries) (gax.CallOption, gax.CallOption, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxAttempts := int64(3)
+	if !retries.MaxAttempts.IsNull() {
+		maxAttempts = retries.MaxAttempts.ValueInt64()
+	}
+
+	if maxAttempts < 1 {
+		diags.AddAttributeError(path.Root("retries").AtName("max_attempts"), "Invalid max_attempts", "The max_attempts must be at least 1.")
+	}
+
+	initialBackoff, initialBackoffDiags := parseRetryBackoff(retries.InitialBackoff, "initial_backoff", time.Second)
+	diags.Append(initialBackoffDiags...)
+
+	maxBackoff, maxBackoffDiags := parseRetryBackoff(retries.MaxBackoff, "max_backoff", 30*time.Second)
+	diags.Append(maxBackoffDiags...)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	if initialBackoff > maxBackoff {
+		diags.AddAttributeError(path.Root("retries").AtName("max_backoff"), "Invalid max_backoff", "The max_backoff must be at least the initial_backoff.")
+		return nil, nil, diags
+	}
+
+	retryOption := func(codes map[int]bool) gax.CallOption {
+		return gax.WithRetry(func() gax.Retryer {
+			return &retryer{
+				backoff: gax.Backoff{
+					Initial:    initialBackoff,
+					Max:        maxBackoff,
+					Multiplier: 2,
+				},
+				codes:       codes,
+				maxAttempts: maxAttempts,
+			}
+		})
+	}
+
+	return retryOption(retryableHTTPCodes), retryOption(retryableMutationHTTPCodes), diags
+}
+
+// parseRetryBackoff parses a backoff of the retries block, falling back to the default when it isn't set.
+func parseRetryBackoff(value types.String, attribute string, defaultBackoff time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return defaultBackoff, diags
+	}
+
+	backoff, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("retries").AtName(attribute), fmt.Sprintf("Invalid %s", attribute), fmt.Sprintf("The %s must be a duration such as `1s`: %s", attribute, err))
+		return 0, diags
+	}
+
+	if backoff <= 0 {
+		diags.AddAttributeError(path.Root("retries").AtName(attribute), fmt.Sprintf("Invalid %s", attribute), fmt.Sprintf("The %s must be positive.", attribute))
+		return 0, diags
+	}
+
+	return backoff, diags
+}
+
+// appendRetryCallOptions adds the retry call options to every method of the CallOptions of a compute client, the one
+// for reads to the methods only reading resources and the one for mutations to the others. Appending keeps the
+// defaults of the client, such as the timeouts, while the later retry policy takes precedence.
+func appendRetryCallOptions(callOptions any, readOption gax.CallOption, mutationOption gax.CallOption) {
+	methods := reflect.ValueOf(callOptions).Elem()
+
+	for i := 0; i < methods.NumField(); i++ {
+		method, ok := methods.Field(i).Interface().([]gax.CallOption)
+		if !ok {
+			continue
+		}
+
+		callOption := mutationOption
+		if readMethod(methods.Type().Field(i).Name) {
+			callOption = readOption
+		}
+
+		methods.Field(i).Set(reflect.ValueOf(append(method, callOption)))
+	}
+}
+
+// readMethod returns whether the method of a compute client only reads resources, such as Get, GetHealth, List,
+// AggregatedList or the Wait of the operations.
+func readMethod(name string) bool {
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List") || name == "AggregatedList" || name == "Wait"
+}
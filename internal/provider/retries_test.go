@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestAppendRetryCallOptions checks the calls changing resources, such as the fingerprinted patches and the endpoint
+// attachments, are only retried when rate limited, while the reads are also retried on server errors.
+func TestAppendRetryCallOptions(t *testing.T) {
+	readOption, mutationOption, diags := retryCallOptions(&GKEGatewayProviderModelRetries{
+		InitialBackoff: types.StringNull(),
+		MaxAttempts:    types.Int64Null(),
+		MaxBackoff:     types.StringNull(),
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	backendServices := &compute.BackendServicesCallOptions{}
+	appendRetryCallOptions(backendServices, readOption, mutationOption)
+
+	networkEndpointGroups := &compute.NetworkEndpointGroupsCallOptions{}
+	appendRetryCallOptions(networkEndpointGroups, readOption, mutationOption)
+
+	tests := map[string]struct {
+		callOptions []gax.CallOption
+		mutation    bool
+	}{
+		"AggregatedList":         {callOptions: backendServices.AggregatedList},
+		"AttachNetworkEndpoints": {callOptions: networkEndpointGroups.AttachNetworkEndpoints, mutation: true},
+		"DetachNetworkEndpoints": {callOptions: networkEndpointGroups.DetachNetworkEndpoints, mutation: true},
+		"Get":                    {callOptions: backendServices.Get},
+		"GetHealth":              {callOptions: backendServices.GetHealth},
+		"List":                   {callOptions: backendServices.List},
+		"ListNetworkEndpoints":   {callOptions: networkEndpointGroups.ListNetworkEndpoints},
+		"Patch":                  {callOptions: backendServices.Patch, mutation: true},
+		"Update":                 {callOptions: backendServices.Update, mutation: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			settings := &gax.CallSettings{}
+			for _, callOption := range test.callOptions {
+				callOption.Resolve(settings)
+			}
+
+			retryer, ok := settings.Retry().(*retryer)
+			if !ok {
+				t.Fatalf("expected the retry policy of the provider, got %T", settings.Retry())
+			}
+
+			if !retryer.codes[http.StatusTooManyRequests] {
+				t.Error("expected a rate limited call to be retried")
+			}
+
+			if retryer.codes[http.StatusServiceUnavailable] == test.mutation {
+				t.Errorf("expected a call failing with a 503 status to be retried: %t", !test.mutation)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// routeLatencyPercentiles are the Cloud Monitoring reducers behind each reported percentile.
+var routeLatencyPercentiles = []string{"REDUCE_PERCENTILE_50", "REDUCE_PERCENTILE_95", "REDUCE_PERCENTILE_99"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RouteLatenciesDataSource{}
+
+func NewRouteLatenciesDataSource() datasource.DataSource {
+	return &RouteLatenciesDataSource{}
+}
+
+// RouteLatenciesDataSource defines the data source implementation.
+type RouteLatenciesDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// RouteLatenciesDataSourceModel describes the data source data model.
+type RouteLatenciesDataSourceModel struct {
+	Gateway   types.String                         `tfsdk:"gateway"`
+	Lookback  types.String                         `tfsdk:"lookback"`
+	Namespace types.String                         `tfsdk:"namespace"`
+	Project   types.String                         `tfsdk:"project"`
+	Region    types.String                         `tfsdk:"region"`
+	Routes    []RouteLatenciesDataSourceModelRoute `tfsdk:"routes"`
+}
+
+type RouteLatenciesDataSourceModelRoute struct {
+	P50         types.Float64 `tfsdk:"p50"`
+	P95         types.Float64 `tfsdk:"p95"`
+	P99         types.Float64 `tfsdk:"p99"`
+	PathMatcher types.String  `tfsdk:"path_matcher"`
+	PathRule    types.String  `tfsdk:"path_rule"`
+}
+
+func (d *RouteLatenciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *RouteLatenciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route_latencies"
+}
+
+func (d *RouteLatenciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data RouteLatenciesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Lookback.IsNull() {
+		data.Lookback = types.StringValue("1h")
+	}
+
+	lookback, diags := parseMonitoringLookback(data.Lookback)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRule, diags := d.providerData.findGatewayForwardingRule(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || forwardingRule == nil {
+		return
+	}
+
+	urlMap, diags := d.providerData.getUrlMap(ctx, project, region, forwardingRule)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cloud Monitoring only knows the matched rule, the route table tells which path matcher it belongs to.
+	pathMatchers := urlMapPathRuleMatchers(urlMap)
+	routes := map[string]*RouteLatenciesDataSourceModelRoute{}
+
+	for _, reducer := range routeLatencyPercentiles {
+		latencies, diags := d.providerData.forwardingRuleRouteLatencies(ctx, project, region, forwardingRule, lookback, reducer)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for pathRule, latency := range latencies {
+			route, ok := routes[pathRule]
+
+			if !ok {
+				route = &RouteLatenciesDataSourceModelRoute{
+					P50:         types.Float64Null(),
+					P95:         types.Float64Null(),
+					P99:         types.Float64Null(),
+					PathMatcher: types.StringNull(),
+					PathRule:    types.StringValue(pathRule),
+				}
+
+				if pathMatcher, ok := pathMatchers[pathRule]; ok {
+					route.PathMatcher = types.StringValue(pathMatcher)
+				}
+
+				routes[pathRule] = route
+			}
+
+			switch reducer {
+			case "REDUCE_PERCENTILE_50":
+				route.P50 = types.Float64Value(latency)
+			case "REDUCE_PERCENTILE_95":
+				route.P95 = types.Float64Value(latency)
+			case "REDUCE_PERCENTILE_99":
+				route.P99 = types.Float64Value(latency)
+			}
+		}
+	}
+
+	pathRules := make([]string, 0, len(routes))
+	for pathRule := range routes {
+		pathRules = append(pathRules, pathRule)
+	}
+
+	sort.Strings(pathRules)
+
+	data.Routes = []RouteLatenciesDataSourceModelRoute{}
+	for _, pathRule := range pathRules {
+		data.Routes = append(data.Routes, *routes[pathRule])
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RouteLatenciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"lookback": schema.StringAttribute{
+				MarkdownDescription: "How far back to compute the latencies over, as a duration such as `30m`. Defaults to `1h`.",
+				Optional:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"routes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"p50": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The median latency of the route, in milliseconds.",
+						},
+						"p95": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The 95th percentile latency of the route, in milliseconds.",
+						},
+						"p99": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The 99th percentile latency of the route, in milliseconds.",
+						},
+						"path_matcher": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the URL map path matcher the rule belongs to - will be null for `UNMATCHED` requests and rules no longer in the URL map.",
+						},
+						"path_rule": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The path rule that matched the requests, as reported by Cloud Monitoring, such as `/api/*` or `UNMATCHED`.",
+						},
+					},
+				},
+				MarkdownDescription: "The latencies of each route that received requests over the lookback window, sorted by path rule.",
+			},
+		},
+		MarkdownDescription: "Reports the p50, p95 and p99 total latencies of the load balancer for a Kubernetes Gateway resource, broken down by the URL map path rule that matched the requests, as reported by Cloud Monitoring. This lets SLAs be tracked per route rather than per backend.",
+	}
+}
+
+// forwardingRuleRouteLatencies returns the latencies, in milliseconds, the given percentile reducer computes over the
+// lookback window for each path rule of the forwarding rule.
+func (p *GKEGatewayProviderData) forwardingRuleRouteLatencies(ctx context.Context, project string, region types.String, forwardingRule *computepb.ForwardingRule, lookback time.Duration, reducer string) (map[string]float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceType, metricType := loadBalancerMetric(region, forwardingRule, "total_latencies")
+
+	end := time.Now().UTC()
+
+	timeSeries, err := p.monitoringService.Projects.TimeSeries.List(fmt.Sprintf("projects/%s", project)).
+		Filter(fmt.Sprintf(`metric.type = "%s" AND resource.type = "%s" AND resource.labels.forwarding_rule_name = "%s"`, metricType, resourceType, forwardingRule.GetName())).
+		IntervalStartTime(end.Add(-lookback).Format(time.RFC3339)).
+		IntervalEndTime(end.Format(time.RFC3339)).
+		AggregationAlignmentPeriod(fmt.Sprintf("%ds", int64(lookback.Seconds()))).
+		AggregationPerSeriesAligner("ALIGN_DELTA").
+		AggregationCrossSeriesReducer(reducer).
+		AggregationGroupByFields("resource.labels.matched_url_path_rule").
+		Context(ctx).
+		Do()
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error looking up the route latencies of forwarding rule %s", forwardingRule.GetName()), fmt.Sprintf("Error calling Google API: %+v", err))
+		return nil, diags
+	}
+
+	latencies := map[string]float64{}
+	for _, series := range timeSeries.TimeSeries {
+		if series.Resource == nil {
+			continue
+		}
+
+		for _, point := range series.Points {
+			if point.Value != nil && point.Value.DoubleValue != nil {
+				latencies[series.Resource.Labels["matched_url_path_rule"]] = *point.Value.DoubleValue
+			}
+		}
+	}
+
+	return latencies, diags
+}
+
+// urlMapPathRuleMatchers maps the paths of the URL map rules to the name of their path matcher.
+func urlMapPathRuleMatchers(urlMap *computepb.UrlMap) map[string]string {
+	pathMatchers := map[string]string{}
+
+	for _, matcher := range urlMap.GetPathMatchers() {
+		for _, rule := range matcher.GetPathRules() {
+			for _, path := range rule.GetPaths() {
+				pathMatchers[path] = matcher.GetName()
+			}
+		}
+
+		// GKE translates HTTPRoute matches into route rules.
+		for _, rule := range matcher.GetRouteRules() {
+			for _, match := range rule.GetMatchRules() {
+				for _, path := range []string{match.GetPrefixMatch(), match.GetFullPathMatch(), match.GetPathTemplateMatch()} {
+					if path != "" {
+						pathMatchers[path] = matcher.GetName()
+					}
+				}
+			}
+		}
+	}
+
+	return pathMatchers
+}
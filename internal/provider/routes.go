@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+)
+
+// urlMapRoute narrows the traversal of a URL map to the part a request would be routed through, so gateways serving
+// several routes resolve to the backend service of the desired one. The zero value keeps the whole URL map.
+type urlMapRoute struct {
+	hostname string
+	path     string
+	priority *int64
+}
+
+// describe names the route in messages, such as `hostname example.com and path /api`. The zero value is described as
+// an empty string.
+func (r urlMapRoute) describe() string {
+	parts := []string{}
+
+	if r.hostname != "" {
+		parts = append(parts, "hostname "+r.hostname)
+	}
+
+	if r.path != "" {
+		parts = append(parts, "path "+r.path)
+	}
+
+	if r.priority != nil {
+		parts = append(parts, "route rule priority "+strconv.FormatInt(*r.priority, 10))
+	}
+
+	return strings.Join(parts, " and ")
+}
+
+// narrow returns the part of the URL map the route goes through, as a URL map of its own, so it can be traversed like
+// the whole one.
+func (r urlMapRoute) narrow(urlMap *computepb.UrlMap) *computepb.UrlMap {
+	if r == (urlMapRoute{}) {
+		return urlMap
+	}
+
+	narrowed := &computepb.UrlMap{
+		DefaultRouteAction: urlMap.DefaultRouteAction,
+		DefaultService:     urlMap.DefaultService,
+		Name:               urlMap.Name,
+		PathMatchers:       urlMap.PathMatchers,
+		SelfLink:           urlMap.SelfLink,
+	}
+
+	// Hosts no host rule matches are routed by the defaults of the URL map, the others by the path matcher of their
+	// host rule. Without a hostname, any of them could be.
+	if r.hostname != "" {
+		if matcher := hostPathMatcher(urlMap, r.hostname); matcher != nil {
+			narrowed.DefaultRouteAction = nil
+			narrowed.DefaultService = nil
+			narrowed.PathMatchers = []*computepb.PathMatcher{matcher}
+		} else {
+			narrowed.PathMatchers = nil
+		}
+	}
+
+	if r.path != "" {
+		pathMatchers := []*computepb.PathMatcher{}
+		for _, matcher := range narrowed.PathMatchers {
+			pathMatchers = append(pathMatchers, routePath(matcher, r.path))
+		}
+
+		narrowed.PathMatchers = pathMatchers
+	}
+
+	// GKE gives each rule of the HTTPRoutes a stable priority, so a route rule can be picked out directly.
+	if r.priority != nil {
+		narrowed.DefaultRouteAction = nil
+		narrowed.DefaultService = nil
+
+		pathMatchers := []*computepb.PathMatcher{}
+		for _, matcher := range narrowed.PathMatchers {
+			for _, rule := range matcher.RouteRules {
+				if int64(rule.GetPriority()) == *r.priority {
+					pathMatchers = append(pathMatchers, &computepb.PathMatcher{
+						DefaultRouteAction: rule.RouteAction,
+						DefaultService:     rule.Service,
+						Name:               matcher.Name,
+					})
+				}
+			}
+		}
+
+		narrowed.PathMatchers = pathMatchers
+	}
+
+	return narrowed
+}
+
+// hostPathMatcher returns the path matcher of the host rule matching the hostname, or nil when none does. Like the load
+// balancer, an exact host wins over the wildcards, and a longer wildcard over a shorter one.
+func hostPathMatcher(urlMap *computepb.UrlMap, hostname string) *computepb.PathMatcher {
+	hostname = strings.ToLower(hostname)
+
+	bestLength := -1
+	bestMatcher := ""
+
+	for _, rule := range urlMap.HostRules {
+		for _, host := range rule.Hosts {
+			host = strings.ToLower(host)
+
+			length := -1
+			if host == hostname {
+				// An exact host is longer than any wildcard matching the same hostname.
+				length = len(hostname) + 1
+			} else if strings.HasPrefix(host, "*") && strings.HasSuffix(hostname, host[1:]) {
+				length = len(host) - 1
+			}
+
+			if length > bestLength {
+				bestLength = length
+				bestMatcher = rule.GetPathMatcher()
+			}
+		}
+	}
+
+	if bestLength < 0 {
+		return nil
+	}
+
+	for _, matcher := range urlMap.PathMatchers {
+		if matcher.GetName() == bestMatcher {
+			return matcher
+		}
+	}
+
+	return nil
+}
+
+// routePath returns the path matcher routing every request to where it routes the path, as its defaults. Route rules
+// are evaluated by priority, and path rules by the longest path matching, before falling back to the defaults of the
+// path matcher.
+func routePath(matcher *computepb.PathMatcher, path string) *computepb.PathMatcher {
+	routed := &computepb.PathMatcher{
+		DefaultRouteAction: matcher.DefaultRouteAction,
+		DefaultService:     matcher.DefaultService,
+		Name:               matcher.Name,
+	}
+
+	// The query string never takes part in the path matching.
+	path, _, _ = strings.Cut(path, "?")
+
+	routeRules := slices.Clone(matcher.RouteRules)
+	slices.SortStableFunc(routeRules, func(a, b *computepb.HttpRouteRule) int {
+		return cmp.Compare(a.GetPriority(), b.GetPriority())
+	})
+
+	for _, rule := range routeRules {
+		if routeRuleMatchesPath(rule, path) {
+			routed.DefaultRouteAction = rule.RouteAction
+			routed.DefaultService = rule.Service
+
+			return routed
+		}
+	}
+
+	bestLength := -1
+
+	for _, rule := range matcher.PathRules {
+		for _, rulePath := range rule.Paths {
+			// Paths ending with /* match any path below them, the others only themselves.
+			matches := rulePath == path
+			if prefix, ok := strings.CutSuffix(rulePath, "*"); ok && strings.HasSuffix(prefix, "/") {
+				matches = strings.HasPrefix(path, prefix)
+			}
+
+			if matches && len(rulePath) > bestLength {
+				bestLength = len(rulePath)
+				routed.DefaultRouteAction = rule.RouteAction
+				routed.DefaultService = rule.Service
+			}
+		}
+	}
+
+	return routed
+}
+
+// routeRuleMatchesPath reports whether any of the match rules of the route rule matches the path. Only the path
+// conditions are evaluated, the ones on headers and query parameters are presumed to hold.
+func routeRuleMatchesPath(rule *computepb.HttpRouteRule, path string) bool {
+	if len(rule.MatchRules) == 0 {
+		return true
+	}
+
+	for _, matchRule := range rule.MatchRules {
+		matchPath := path
+		if matchRule.GetIgnoreCase() {
+			matchPath = strings.ToLower(path)
+		}
+
+		switch {
+		case matchRule.PrefixMatch != nil:
+			if strings.HasPrefix(matchPath, caseFold(matchRule.GetPrefixMatch(), matchRule.GetIgnoreCase())) {
+				return true
+			}
+		case matchRule.FullPathMatch != nil:
+			if matchPath == caseFold(matchRule.GetFullPathMatch(), matchRule.GetIgnoreCase()) {
+				return true
+			}
+		case matchRule.RegexMatch != nil:
+			// The load balancer uses RE2 as well, matching the whole path regardless of ignoreCase.
+			if re, err := regexp.Compile("^(?:" + matchRule.GetRegexMatch() + ")$"); err == nil && re.MatchString(path) {
+				return true
+			}
+		case matchRule.PathTemplateMatch != nil:
+			if pathTemplateRegexp(matchRule.GetPathTemplateMatch()).MatchString(path) {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// caseFold lowers the value when the match ignores the case.
+func caseFold(value string, ignoreCase bool) string {
+	if ignoreCase {
+		return strings.ToLower(value)
+	}
+
+	return value
+}
+
+// pathTemplateRegexp converts a path template such as `/videos/{id}/**` to the regular expression of the paths it
+// matches, where `*` and plain variables match one segment, and `**` matches any number of them.
+func pathTemplateRegexp(template string) *regexp.Regexp {
+	segments := []string{}
+
+	for _, segment := range strings.Split(template, "/") {
+		// Variables match like their pattern, which is a single segment unless told otherwise.
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			pattern := "*"
+			if _, variablePattern, ok := strings.Cut(strings.Trim(segment, "{}"), "="); ok {
+				pattern = variablePattern
+			}
+
+			segment = pattern
+		}
+
+		switch segment {
+		case "**":
+			segments = append(segments, ".*")
+		case "*":
+			segments = append(segments, "[^/]+")
+		default:
+			segments = append(segments, regexp.QuoteMeta(segment))
+		}
+	}
+
+	return regexp.MustCompile("^" + strings.Join(segments, "/") + "$")
+}
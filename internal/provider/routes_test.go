@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// testRoutesComputeResources returns the load balancer of testAccGatewayComputeResources with a URL map routing by
+// host, path rules and route rules, whose backend services are named after what routes to them. Like GKE does, the
+// route rules split the traffic with route actions.
+func testRoutesComputeResources() map[string]proto.Message {
+	const prefix = "https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/backendServices/"
+
+	resources := testAccGatewayComputeResources()
+	resources["projects/my-gcp-project/global/urlMaps/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"] = &computepb.UrlMap{
+		DefaultService: proto.String(prefix + "default"),
+		HostRules: []*computepb.HostRule{
+			{Hosts: []string{"example.com"}, PathMatcher: proto.String("exact")},
+			{Hosts: []string{"*.example.com"}, PathMatcher: proto.String("wildcard")},
+			{Hosts: []string{"*.api.example.com"}, PathMatcher: proto.String("api")},
+		},
+		Name: proto.String("gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+		PathMatchers: []*computepb.PathMatcher{
+			{
+				DefaultService: proto.String(prefix + "exact-default"),
+				Name:           proto.String("exact"),
+				PathRules: []*computepb.PathRule{
+					{Paths: []string{"/static/*"}, Service: proto.String(prefix + "static")},
+					{Paths: []string{"/static/images/*"}, Service: proto.String(prefix + "images")},
+					{Paths: []string{"/about"}, Service: proto.String(prefix + "about")},
+				},
+			},
+			{
+				DefaultService: proto.String(prefix + "wildcard-default"),
+				Name:           proto.String("wildcard"),
+			},
+			{
+				DefaultService: proto.String(prefix + "api-default"),
+				Name:           proto.String("api"),
+				RouteRules: []*computepb.HttpRouteRule{
+					{
+						MatchRules: []*computepb.HttpRouteRuleMatch{{PrefixMatch: proto.String("/v1/")}},
+						Priority:   proto.Int32(2),
+						RouteAction: &computepb.HttpRouteAction{
+							WeightedBackendServices: []*computepb.WeightedBackendService{{BackendService: proto.String(prefix + "v1"), Weight: proto.Uint32(100)}},
+						},
+					},
+					{
+						MatchRules: []*computepb.HttpRouteRuleMatch{{FullPathMatch: proto.String("/v1/health"), IgnoreCase: proto.Bool(true)}},
+						Priority:   proto.Int32(1),
+						RouteAction: &computepb.HttpRouteAction{
+							WeightedBackendServices: []*computepb.WeightedBackendService{{BackendService: proto.String(prefix + "health"), Weight: proto.Uint32(100)}},
+						},
+					},
+					{
+						MatchRules: []*computepb.HttpRouteRuleMatch{{RegexMatch: proto.String("/v[0-9]+/users/[0-9]+")}},
+						Priority:   proto.Int32(3),
+						RouteAction: &computepb.HttpRouteAction{
+							WeightedBackendServices: []*computepb.WeightedBackendService{{BackendService: proto.String(prefix + "users"), Weight: proto.Uint32(100)}},
+						},
+					},
+					{
+						MatchRules: []*computepb.HttpRouteRuleMatch{{PathTemplateMatch: proto.String("/videos/{id}/**")}},
+						Priority:   proto.Int32(4),
+						RouteAction: &computepb.HttpRouteAction{
+							WeightedBackendServices: []*computepb.WeightedBackendService{{BackendService: proto.String(prefix + "videos"), Weight: proto.Uint32(100)}},
+						},
+					},
+				},
+			},
+		},
+		SelfLink: proto.String("https://www.googleapis.com/compute/v1/projects/my-gcp-project/global/urlMaps/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"),
+	}
+
+	return resources
+}
+
+// TestForwardingRuleBackendServicePathsRoutes checks the URL map served by the compute API is followed along the route
+// like the load balancer routes a request, by host, then by route rule priority or longest path rule.
+func TestForwardingRuleBackendServicePathsRoutes(t *testing.T) {
+	resources := testRoutesComputeResources()
+	forwardingRule := resources["projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"].(*computepb.ForwardingRule)
+
+	p := testComputeProviderData(t, testAccComputeServer(t, resources), nil)
+
+	tests := map[string]struct {
+		route    urlMapRoute
+		expected []string
+	}{
+		"unknown host": {
+			route:    urlMapRoute{hostname: "example.org"},
+			expected: []string{"default"},
+		},
+		"wildcard host": {
+			route:    urlMapRoute{hostname: "WWW.Example.com"},
+			expected: []string{"wildcard-default"},
+		},
+		"longer wildcard host": {
+			route:    urlMapRoute{hostname: "eu.api.example.com"},
+			expected: []string{"api-default", "health", "users", "v1", "videos"},
+		},
+		"longest path rule": {
+			route:    urlMapRoute{hostname: "example.com", path: "/static/images/logo.png"},
+			expected: []string{"images"},
+		},
+		"path rule prefix": {
+			route:    urlMapRoute{hostname: "example.com", path: "/static/site.css"},
+			expected: []string{"static"},
+		},
+		"exact path rule with query string": {
+			route:    urlMapRoute{hostname: "example.com", path: "/about?lang=en"},
+			expected: []string{"about"},
+		},
+		"no path rule": {
+			route:    urlMapRoute{hostname: "example.com", path: "/contact"},
+			expected: []string{"exact-default"},
+		},
+		"route rule priority order": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", path: "/v1/health"},
+			expected: []string{"health"},
+		},
+		"route rule ignoring case": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", path: "/V1/Health"},
+			expected: []string{"health"},
+		},
+		"route rule prefix": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", path: "/v1/users/42"},
+			expected: []string{"v1"},
+		},
+		"route rule regex": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", path: "/v2/users/42"},
+			expected: []string{"users"},
+		},
+		"route rule path template": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", path: "/videos/abc/1080p/segment.ts"},
+			expected: []string{"videos"},
+		},
+		"no route rule": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", path: "/videos"},
+			expected: []string{"api-default"},
+		},
+		"route rule priority": {
+			route:    urlMapRoute{hostname: "eu.api.example.com", priority: proto.Int64(3)},
+			expected: []string{"users"},
+		},
+		"unknown route rule priority": {
+			route:    urlMapRoute{priority: proto.Int64(99)},
+			expected: []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			backendServicePaths, diags := p.forwardingRuleBackendServicePaths(context.Background(), "my-gcp-project", types.StringNull(), forwardingRule, test.route)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			got := []string{}
+			for _, backendServicePath := range backendServicePaths {
+				got = append(got, path.Base(*backendServicePath))
+			}
+
+			slices.Sort(got)
+
+			if !slices.Equal(slices.Compact(got), test.expected) {
+				t.Errorf("expected backend services %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+// TestForwardingRuleBackendServiceNoRoute checks a route the URL map has no rule for is reported along with what to
+// check.
+func TestForwardingRuleBackendServiceNoRoute(t *testing.T) {
+	resources := testRoutesComputeResources()
+	forwardingRule := resources["projects/my-gcp-project/global/forwardingRules/gkegw1-0a1b-my-cool-app-my-gateway-name-abcd"].(*computepb.ForwardingRule)
+
+	p := testComputeProviderData(t, testAccComputeServer(t, resources), nil)
+
+	_, diags := p.forwardingRuleBackendService(context.Background(), "my-gcp-project", types.StringNull(), forwardingRule, urlMapRoute{hostname: "example.com", priority: proto.Int64(99)})
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected an error, got %v", diags)
+	}
+
+	expected := "routes no request for hostname example.com and route rule priority 99 to a backend service"
+	if diags.Errors()[0].Summary() != "No backend services found" || !strings.Contains(diags.Errors()[0].Detail(), expected) {
+		t.Errorf("expected an error containing %q, got %v", expected, diags)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecurityPolicyDataSource{}
+
+func NewSecurityPolicyDataSource() datasource.DataSource {
+	return &SecurityPolicyDataSource{}
+}
+
+// SecurityPolicyDataSource defines the data source implementation.
+type SecurityPolicyDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// SecurityPolicyDataSourceModel describes the data source data model.
+type SecurityPolicyDataSourceModel struct {
+	Gateway        types.String                                 `tfsdk:"gateway"`
+	Namespace      types.String                                 `tfsdk:"namespace"`
+	Project        types.String                                 `tfsdk:"project"`
+	Region         types.String                                 `tfsdk:"region"`
+	SecurityPolicy *SecurityPolicyDataSourceModelSecurityPolicy `tfsdk:"security_policy"`
+}
+
+type SecurityPolicyDataSourceModelSecurityPolicy struct {
+	Name     types.String `tfsdk:"name"`
+	SelfLink types.String `tfsdk:"self_link"`
+	Type     types.String `tfsdk:"type"`
+}
+
+func (d *SecurityPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *SecurityPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_policy"
+}
+
+func (d *SecurityPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data SecurityPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendService, diags := d.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || backendService == nil {
+		return
+	}
+
+	// A GCPBackendPolicy without a security policy leaves this unset.
+	if backendService.GetSecurityPolicy() == "" {
+		return
+	}
+
+	securityPolicyComponents := strings.Split(backendService.GetSecurityPolicy(), "/")
+
+	var (
+		err            error
+		securityPolicy *computepb.SecurityPolicy
+	)
+
+	if region.IsNull() {
+		securityPolicy, err = d.providerData.securityPoliciesClient.Get(ctx, &computepb.GetSecurityPolicyRequest{
+			Project:        project,
+			SecurityPolicy: securityPolicyComponents[len(securityPolicyComponents)-1],
+		})
+	} else {
+		securityPolicy, err = d.providerData.regionSecurityPoliciesClient.Get(ctx, &computepb.GetRegionSecurityPolicyRequest{
+			Project:        project,
+			Region:         region.ValueString(),
+			SecurityPolicy: securityPolicyComponents[len(securityPolicyComponents)-1],
+		})
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error looking up security policy %s", securityPolicyComponents[len(securityPolicyComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
+		return
+	}
+
+	data.SecurityPolicy = &SecurityPolicyDataSourceModelSecurityPolicy{
+		Name:     types.StringValue(securityPolicy.GetName()),
+		SelfLink: types.StringValue(securityPolicy.GetSelfLink()),
+		Type:     types.StringValue(securityPolicy.GetType()),
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SecurityPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"security_policy": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the security policy.",
+					},
+					"self_link": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "URI of the security policy.",
+					},
+					"type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The type of the security policy, such as `CLOUD_ARMOR` or `CLOUD_ARMOR_EDGE`.",
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Details about the Cloud Armor security policy attached to the backend service - will be null if none is attached.",
+			},
+		},
+		MarkdownDescription: "Finds the Cloud Armor security policy attached, typically through a GCPBackendPolicy, to the backend service of the load balancer for a Kubernetes Gateway resource. The same single backend service limitations as the `gkegateway_backend_service` data source apply.",
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/iterator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServiceAttachmentDataSource{}
+
+func NewServiceAttachmentDataSource() datasource.DataSource {
+	return &ServiceAttachmentDataSource{}
+}
+
+// ServiceAttachmentDataSource defines the data source implementation.
+type ServiceAttachmentDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// ServiceAttachmentDataSourceModel describes the data source data model.
+type ServiceAttachmentDataSourceModel struct {
+	Gateway           types.String                                       `tfsdk:"gateway"`
+	Namespace         types.String                                       `tfsdk:"namespace"`
+	Project           types.String                                       `tfsdk:"project"`
+	Region            types.String                                       `tfsdk:"region"`
+	ServiceAttachment *ServiceAttachmentDataSourceModelServiceAttachment `tfsdk:"service_attachment"`
+}
+
+type ServiceAttachmentDataSourceModelConsumerAcceptList struct {
+	ConnectionLimit types.Int64  `tfsdk:"connection_limit"`
+	NetworkUrl      types.String `tfsdk:"network_url"`
+	ProjectIdOrNum  types.String `tfsdk:"project_id_or_num"`
+}
+
+type ServiceAttachmentDataSourceModelServiceAttachment struct {
+	ConnectionPreference types.String                                         `tfsdk:"connection_preference"`
+	ConsumerAcceptLists  []ServiceAttachmentDataSourceModelConsumerAcceptList `tfsdk:"consumer_accept_lists"`
+	ConsumerRejectLists  []types.String                                       `tfsdk:"consumer_reject_lists"`
+	EnableProxyProtocol  types.Bool                                           `tfsdk:"enable_proxy_protocol"`
+	Name                 types.String                                         `tfsdk:"name"`
+	SelfLink             types.String                                         `tfsdk:"self_link"`
+}
+
+func (d *ServiceAttachmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *ServiceAttachmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_attachment"
+}
+
+func (d *ServiceAttachmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data ServiceAttachmentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Private Service Connect only publishes internal load balancers, which are regional.
+	if region.IsNull() {
+		resp.Diagnostics.AddError("Missing region", "Service attachments are regional, the region field must be set on either the provider or data source.")
+		return
+	}
+
+	forwardingRule, diags := d.providerData.findGatewayForwardingRule(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || forwardingRule == nil {
+		return
+	}
+
+	serviceAttachmentsIterator := d.providerData.serviceAttachmentsClient.List(ctx, &computepb.ListServiceAttachmentsRequest{
+		Project: project,
+		Region:  region.ValueString(),
+	})
+
+	var serviceAttachment *computepb.ServiceAttachment
+
+	for {
+		attachment, err := serviceAttachmentsIterator.Next()
+
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			// Ignore 404 errors for projects that don't exist yet.
+			if e, ok := err.(*apierror.APIError); ok && e.HTTPCode() == 404 {
+				return
+			}
+
+			resp.Diagnostics.AddError("Unable to iterate over service attachments", fmt.Sprintf("Error calling Google API: %+v", err))
+			return
+		}
+
+		// The target service can be a self_link for another API version or a relative ID.
+		if sameComputeResource(attachment.GetTargetService(), forwardingRule.GetSelfLink()) {
+			serviceAttachment = attachment
+			break
+		}
+	}
+
+	// The gateway isn't published.
+	if serviceAttachment == nil {
+		return
+	}
+
+	data.ServiceAttachment = &ServiceAttachmentDataSourceModelServiceAttachment{
+		ConnectionPreference: types.StringValue(serviceAttachment.GetConnectionPreference()),
+		ConsumerAcceptLists:  []ServiceAttachmentDataSourceModelConsumerAcceptList{},
+		ConsumerRejectLists:  []types.String{},
+		EnableProxyProtocol:  types.BoolValue(serviceAttachment.GetEnableProxyProtocol()),
+		Name:                 types.StringValue(serviceAttachment.GetName()),
+		SelfLink:             types.StringValue(serviceAttachment.GetSelfLink()),
+	}
+
+	for _, limit := range serviceAttachment.GetConsumerAcceptLists() {
+		acceptList := ServiceAttachmentDataSourceModelConsumerAcceptList{
+			ConnectionLimit: types.Int64Value(int64(limit.GetConnectionLimit())),
+			NetworkUrl:      types.StringNull(),
+			ProjectIdOrNum:  types.StringNull(),
+		}
+
+		if limit.NetworkUrl != nil {
+			acceptList.NetworkUrl = types.StringValue(limit.GetNetworkUrl())
+		}
+
+		if limit.ProjectIdOrNum != nil {
+			acceptList.ProjectIdOrNum = types.StringValue(limit.GetProjectIdOrNum())
+		}
+
+		data.ServiceAttachment.ConsumerAcceptLists = append(data.ServiceAttachment.ConsumerAcceptLists, acceptList)
+	}
+
+	for _, rejected := range serviceAttachment.GetConsumerRejectLists() {
+		data.ServiceAttachment.ConsumerRejectLists = append(data.ServiceAttachment.ConsumerRejectLists, types.StringValue(rejected))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ServiceAttachmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. One of them must be set, as service attachments are regional.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"service_attachment": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"connection_preference": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "How consumer connections are accepted, either `ACCEPT_AUTOMATIC` or `ACCEPT_MANUAL`.",
+					},
+					"consumer_accept_lists": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"connection_limit": schema.Int64Attribute{
+									Computed:            true,
+									MarkdownDescription: "The number of consumer endpoints that can connect from the project or network.",
+								},
+								"network_url": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "URI of the accepted consumer network - will be null if a project is accepted instead.",
+								},
+								"project_id_or_num": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "ID or number of the accepted consumer project - will be null if a network is accepted instead.",
+								},
+							},
+						},
+						MarkdownDescription: "The consumers accepted when the connection preference is `ACCEPT_MANUAL`.",
+					},
+					"consumer_reject_lists": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The IDs or numbers of the consumer projects that are rejected.",
+					},
+					"enable_proxy_protocol": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the PROXY protocol is used to pass the consumer connection details to the load balancer.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the service attachment.",
+					},
+					"self_link": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "URI of the service attachment, which consumers use as the target of their endpoint.",
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Details about the service attachment publishing the forwarding rule - will be null if it isn't published.",
+			},
+		},
+		MarkdownDescription: "Finds the Private Service Connect service attachment publishing the forwarding rule of the internal load balancer created from a Kubernetes Gateway resource by GKE, typically through a ServiceAttachment resource, so consumer projects can connect to it.",
+	}
+}
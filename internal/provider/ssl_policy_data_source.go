@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SslPolicyDataSource{}
+
+func NewSslPolicyDataSource() datasource.DataSource {
+	return &SslPolicyDataSource{}
+}
+
+// SslPolicyDataSource defines the data source implementation.
+type SslPolicyDataSource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// SslPolicyDataSourceModel describes the data source data model.
+type SslPolicyDataSourceModel struct {
+	Gateway   types.String                       `tfsdk:"gateway"`
+	Namespace types.String                       `tfsdk:"namespace"`
+	Project   types.String                       `tfsdk:"project"`
+	Region    types.String                       `tfsdk:"region"`
+	SslPolicy *SslPolicyDataSourceModelSslPolicy `tfsdk:"ssl_policy"`
+}
+
+type SslPolicyDataSourceModelSslPolicy struct {
+	CustomFeatures  []types.String `tfsdk:"custom_features"`
+	EnabledFeatures []types.String `tfsdk:"enabled_features"`
+	MinTlsVersion   types.String   `tfsdk:"min_tls_version"`
+	Name            types.String   `tfsdk:"name"`
+	Profile         types.String   `tfsdk:"profile"`
+	SelfLink        types.String   `tfsdk:"self_link"`
+}
+
+func (d *SslPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = data
+}
+
+func (d *SslPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_policy"
+}
+
+func (d *SslPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data SslPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, region, diags := d.providerData.resolveScope(data.Project, data.Region)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwardingRule, diags := d.providerData.findGatewayForwardingRule(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || forwardingRule == nil {
+		return
+	}
+
+	// Only HTTPS and SSL proxies carry an SSL policy.
+	targetComponents := strings.Split(forwardingRule.GetTarget(), "/")
+
+	var proxySslPolicy string
+
+	switch targetComponents[len(targetComponents)-2] {
+	case "targetHttpsProxies":
+		proxy, diags := d.providerData.getTargetHttpsProxy(ctx, selfLinkProject(forwardingRule.GetTarget(), project), region, targetComponents[len(targetComponents)-1])
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		proxySslPolicy = proxy.GetSslPolicy()
+	case "targetSslProxies":
+		proxy, diags := d.providerData.getTargetSslProxy(ctx, selfLinkProject(forwardingRule.GetTarget(), project), region, targetComponents[len(targetComponents)-1])
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		proxySslPolicy = proxy.GetSslPolicy()
+	default:
+		resp.Diagnostics.AddError("Unsupported target type for forwarding rule", fmt.Sprintf("The %s forwarding rule has a target with a type of %s which does not support SSL policies.", forwardingRule.GetName(), targetComponents[len(targetComponents)-2]))
+		return
+	}
+
+	// Without a GCPGatewayPolicy the proxy uses the default policy, which isn't a resource.
+	if proxySslPolicy == "" {
+		return
+	}
+
+	sslPolicyComponents := strings.Split(proxySslPolicy, "/")
+
+	var (
+		err       error
+		sslPolicy *computepb.SslPolicy
+	)
+
+	if region.IsNull() {
+		sslPolicy, err = d.providerData.sslPoliciesClient.Get(ctx, &computepb.GetSslPolicyRequest{
+			Project:   project,
+			SslPolicy: sslPolicyComponents[len(sslPolicyComponents)-1],
+		})
+	} else {
+		sslPolicy, err = d.providerData.regionSslPoliciesClient.Get(ctx, &computepb.GetRegionSslPolicyRequest{
+			Project:   project,
+			Region:    region.ValueString(),
+			SslPolicy: sslPolicyComponents[len(sslPolicyComponents)-1],
+		})
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error looking up SSL policy %s", sslPolicyComponents[len(sslPolicyComponents)-1]), fmt.Sprintf("Error calling Google API: %+v", err))
+		return
+	}
+
+	data.SslPolicy = &SslPolicyDataSourceModelSslPolicy{
+		CustomFeatures:  []types.String{},
+		EnabledFeatures: []types.String{},
+		MinTlsVersion:   types.StringValue(sslPolicy.GetMinTlsVersion()),
+		Name:            types.StringValue(sslPolicy.GetName()),
+		Profile:         types.StringValue(sslPolicy.GetProfile()),
+		SelfLink:        types.StringValue(sslPolicy.GetSelfLink()),
+	}
+
+	for _, feature := range sslPolicy.GetCustomFeatures() {
+		data.SslPolicy.CustomFeatures = append(data.SslPolicy.CustomFeatures, types.StringValue(feature))
+	}
+
+	for _, feature := range sslPolicy.GetEnabledFeatures() {
+		data.SslPolicy.EnabledFeatures = append(data.SslPolicy.EnabledFeatures, types.StringValue(feature))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SslPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				Required:            true,
+				Validators:          []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				Validators:          []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				Validators:          []validator.String{regionValidator()},
+			},
+			"ssl_policy": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"custom_features": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The features selected when the profile is `CUSTOM`.",
+					},
+					"enabled_features": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The list of features enabled by the policy's profile and minimum TLS version.",
+					},
+					"min_tls_version": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The minimum version of TLS that clients can use to negotiate with the load balancer, such as `TLS_1_2`.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the SSL policy.",
+					},
+					"profile": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The profile of the SSL policy, one of `COMPATIBLE`, `MODERN`, `RESTRICTED` or `CUSTOM`.",
+					},
+					"self_link": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "URI of the SSL policy.",
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Details about the SSL policy attached to the target HTTPS proxy - will be null if the proxy uses the default policy.",
+			},
+		},
+		MarkdownDescription: "Finds the SSL policy attached, typically through a GCPGatewayPolicy, to the target HTTPS or SSL proxy of the load balancer for a Kubernetes Gateway resource.",
+	}
+}
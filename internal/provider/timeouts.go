@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DataSourceTimeoutsModel describes the timeouts block of the data sources, laid out like the one of
+// terraform-plugin-framework-timeouts so configurations are the same as for the other providers.
+type DataSourceTimeoutsModel struct {
+	Read types.String `tfsdk:"read"`
+}
+
+// dataSourceTimeoutsBlock returns the schema of the timeouts block of the data sources.
+func dataSourceTimeoutsBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"read": schema.StringAttribute{
+				MarkdownDescription: "How long the lookup may take, as a duration such as `2m`, regardless of the `request_timeout` of each call to the Google APIs. If it is not provided, the lookup has no deadline.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Deadlines of the data source operations.",
+	}
+}
+
+// withReadTimeout returns the context of the lookup, bounded by the read timeout when one is set. The returned
+// function must be called once the lookup is done.
+func withReadTimeout(ctx context.Context, timeouts *DataSourceTimeoutsModel) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	if timeouts == nil || timeouts.Read.IsNull() {
+		return ctx, func() {}, nil
+	}
+
+	timeout, diags := parseReadTimeout(timeouts.Read)
+
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, cancel, diags
+}
+
+// parseReadTimeout parses the read timeout of the timeouts block.
+func parseReadTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timeouts").AtName("read"), "Invalid read timeout", fmt.Sprintf("The read timeout must be a duration such as `2m`: %s", err))
+		return 0, diags
+	}
+
+	if timeout <= 0 {
+		diags.AddAttributeError(path.Root("timeouts").AtName("read"), "Invalid read timeout", "The read timeout must be positive.")
+		return 0, diags
+	}
+
+	return timeout, diags
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+)
+
+// gatewayTopology is the graph of the load balancer components, rendered as JSON for tools that draw it. Nodes are
+// identified by their self_link and edges point from a component to the one it references.
+type gatewayTopology struct {
+	Edges []gatewayTopologyEdge `json:"edges"`
+	Nodes []gatewayTopologyNode `json:"nodes"`
+}
+
+type gatewayTopologyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type gatewayTopologyNode struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// addEdge links two nodes, once however many times a component references the other.
+func (t *gatewayTopology) addEdge(from string, to string) {
+	for _, edge := range t.Edges {
+		if edge.From == from && edge.To == to {
+			return
+		}
+	}
+
+	t.Edges = append(t.Edges, gatewayTopologyEdge{
+		From: from,
+		To:   to,
+	})
+}
+
+func (t *gatewayTopology) addNode(kind string, name string, selfLink string) {
+	t.Nodes = append(t.Nodes, gatewayTopologyNode{
+		ID:   selfLink,
+		Name: name,
+		Type: kind,
+	})
+}
+
+func (t *gatewayTopology) String() string {
+	// Only plain strings are marshalled, this can't fail.
+	topology, _ := json.Marshal(t)
+
+	return string(topology)
+}
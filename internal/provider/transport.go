@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// loggingTransport logs a summary of each request to the Google APIs, to diagnose lookups that find nothing.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := t.base.RoundTrip(req)
+
+	fields := map[string]any{
+		"latency_ms": time.Since(start).Milliseconds(),
+		"method":     req.Method,
+		"url":        req.URL.String(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = resp.StatusCode
+	}
+
+	tflog.Debug(req.Context(), "Google API request", fields)
+
+	return resp, err
+}
+
+// caBundleTransport returns a copy of the default transport trusting the certificates of the bundle on top of the
+// system ones. It still honors HTTPS_PROXY and NO_PROXY, and only the clients of the configuration use it, so other
+// configurations of the provider running in the same process keep their own trust store.
+func caBundleTransport(caBundle string) (*http.Transport, error) {
+	bundle, err := readCABundle(caBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("the default HTTP transport was replaced")
+	}
+
+	transport := defaultTransport.Clone()
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+
+	// The pool is copied, as the cloned TLS configuration still shares it with the default transport.
+	pool := x509.NewCertPool()
+	if transport.TLSClientConfig.RootCAs != nil {
+		pool = transport.TLSClientConfig.RootCAs.Clone()
+	} else if systemPool, err := x509.SystemCertPool(); err == nil {
+		pool = systemPool
+	}
+
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, errors.New("no PEM certificates found")
+	}
+
+	transport.TLSClientConfig.RootCAs = pool
+
+	return transport, nil
+}
+
+// readCABundle returns the PEM certificates, which are either given inline or as the path of a bundle file.
+func readCABundle(caBundle string) ([]byte, error) {
+	if strings.Contains(caBundle, "-----BEGIN") {
+		return []byte(caBundle), nil
+	}
+
+	return os.ReadFile(caBundle)
+}
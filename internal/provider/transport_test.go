@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/api/option"
+)
+
+// TestCABundleTransport checks the clients of a configuration with a ca_bundle trust it, while the default transport
+// shared by the other configurations of the process doesn't.
+func TestCABundleTransport(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "gkegw1-test"}`))
+	}))
+	// The request through the default transport fails the handshake on purpose.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	transport, err := caBundleTransport(caBundle)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil && config.RootCAs != nil {
+		t.Fatal("expected the trust store of the default transport to be left unchanged")
+	}
+
+	resp, err := http.Get(server.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("expected the default transport not to trust the bundle")
+	}
+
+	ctx := context.Background()
+
+	clientOptions, diags := googleClientOptions(ctx, GKEGatewayProviderModel{
+		AccessToken:                        types.StringValue("test"),
+		ImpersonateServiceAccountDelegates: types.ListNull(types.StringType),
+		Scopes:                             types.ListNull(types.StringType),
+	}, transport)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	client, err := compute.NewForwardingRulesRESTClient(ctx, append(clientOptions, option.WithEndpoint(server.URL))...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	forwardingRule, err := client.Get(ctx, &computepb.GetForwardingRuleRequest{
+		ForwardingRule: "gkegw1-test",
+		Project:        "test",
+		Region:         "us-central1",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if forwardingRule.GetName() != "gkegw1-test" {
+		t.Errorf("expected forwarding rule gkegw1-test, got %q", forwardingRule.GetName())
+	}
+}
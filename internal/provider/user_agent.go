@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/gax-go/v2/callctx"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GKEGatewayProviderMetaModel describes the provider_meta data model, which modules use to attribute their API calls.
+type GKEGatewayProviderMetaModel struct {
+	ModuleName types.String `tfsdk:"module_name"`
+}
+
+// providerUserAgent returns the User-Agent of the provider, followed by the extension when one is configured.
+func providerUserAgent(terraformVersion string, version string, extension types.String) string {
+	userAgent := fmt.Sprintf("Terraform/%s (+https://www.terraform.io) terraform-provider-gkegateway/%s", terraformVersion, version)
+
+	if extension.ValueString() != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, strings.TrimSpace(extension.ValueString()))
+	}
+
+	return userAgent
+}
+
+// withUserAgent returns the context the compute API calls of a request are made with, carrying the User-Agent of the
+// provider and the module_name of the calling module, if it sets one in provider_meta.
+func (p *GKEGatewayProviderData) withUserAgent(ctx context.Context, providerMeta tfsdk.Config) context.Context {
+	if p == nil {
+		return ctx
+	}
+
+	userAgent := p.userAgent
+
+	// The attribution is best effort, a provider_meta that can't be read must not fail the request.
+	var meta GKEGatewayProviderMetaModel
+	if !providerMeta.Raw.IsNull() && !providerMeta.Get(ctx, &meta).HasError() && meta.ModuleName.ValueString() != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, meta.ModuleName.ValueString())
+	}
+
+	return callctx.SetHeaders(ctx, "User-Agent", userAgent)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	// GCP project IDs are 6 to 30 lowercase letters, digits and hyphens, starting with a letter and not ending with a
+	// hyphen, optionally prefixed by the domain of legacy domain-scoped projects.
+	projectIDPattern = regexp.MustCompile(`^(?:[a-z][-a-z0-9.]*[a-z0-9]:)?[a-z][-a-z0-9]{4,28}[a-z0-9]$`)
+	// GCP regions are a geography followed by a numbered area, such as us-central1 or northamerica-northeast2.
+	regionPattern = regexp.MustCompile(`^[a-z]+(?:-[a-z]+)*[0-9]+$`)
+	// Kubernetes namespaces and gateways are named with RFC 1123 labels.
+	rfc1123LabelPattern = regexp.MustCompile(`^[a-z0-9](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
+)
+
+// patternValidator validates that a string attribute matches a pattern, so typos are reported when validating the
+// configuration rather than as an empty lookup result during the apply.
+type patternValidator struct {
+	description string
+	example     string
+	pattern     *regexp.Regexp
+}
+
+var _ validator.String = patternValidator{}
+
+// projectIDValidator validates GCP project IDs.
+func projectIDValidator() patternValidator {
+	return patternValidator{description: "a GCP project ID", example: "my-project-123", pattern: projectIDPattern}
+}
+
+// regionValidator validates GCP region names.
+func regionValidator() patternValidator {
+	return patternValidator{description: "a GCP region", example: "us-central1", pattern: regionPattern}
+}
+
+// rfc1123LabelValidator validates the names of Kubernetes namespaces and gateways.
+func rfc1123LabelValidator() patternValidator {
+	return patternValidator{description: "an RFC 1123 label of at most 63 lowercase alphanumeric characters or hyphens", example: "my-app", pattern: rfc1123LabelPattern}
+}
+
+func (v patternValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be %s, such as %s", v.description, v.example)
+}
+
+func (v patternValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be %s, such as `%s`", v.description, v.example)
+}
+
+func (v patternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.pattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s", req.Path), fmt.Sprintf("The %s must be %s, such as `%s`, got `%s`.", req.Path, v.description, v.example, req.ConfigValue.ValueString()))
+	}
+}
+
+// int64RangeValidator validates that an integer attribute is within a range, so values the API fields can't hold are
+// reported when validating the configuration rather than wrapped around silently.
+type int64RangeValidator struct {
+	maximum int64
+	minimum int64
+}
+
+var _ validator.Int64 = int64RangeValidator{}
+
+// portValidator validates TCP and UDP port numbers.
+func portValidator() int64RangeValidator {
+	return int64RangeValidator{maximum: 65535, minimum: 1}
+}
+
+func (v int64RangeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.minimum, v.maximum)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueInt64(); value < v.minimum || value > v.maximum {
+		resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s", req.Path), fmt.Sprintf("The %s must be between %d and %d, got %d.", req.Path, v.minimum, v.maximum, value))
+	}
+}
+
+// oneOfValidator validates that a string attribute is one of the values of an enum of the API.
+type oneOfValidator struct {
+	values []string
+}
+
+var _ validator.String = oneOfValidator{}
+
+func (v oneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %s", strings.ReplaceAll(v.list(), "`", ""))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %s", v.list())
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s", req.Path), fmt.Sprintf("The %s must be one of %s, got `%s`.", req.Path, v.list(), req.ConfigValue.ValueString()))
+	}
+}
+
+// list returns the values as they are listed in messages, such as `HTTP`, `HTTPS` or `HTTP2`.
+func (v oneOfValidator) list() string {
+	quoted := []string{}
+	for _, value := range v.values {
+		quoted = append(quoted, "`"+value+"`")
+	}
+
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
+
+// providerConfigValidator validates a combination of the settings of the provider, reporting attribute-scoped
+// diagnostics.
+type providerConfigValidator struct {
+	// attributes are the attributes validate reads, the others are left null so an unknown value elsewhere in the
+	// configuration doesn't fail every validator.
+	attributes  []string
+	description string
+	validate    func(data *GKEGatewayProviderModel, diags *diag.Diagnostics)
+}
+
+var _ provider.ConfigValidator = providerConfigValidator{}
+
+func (v providerConfigValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v providerConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.description
+}
+
+func (v providerConfigValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data GKEGatewayProviderModel
+
+	model := reflect.ValueOf(&data).Elem()
+
+	for i := 0; i < model.NumField(); i++ {
+		name := model.Type().Field(i).Tag.Get("tfsdk")
+
+		if slices.Contains(v.attributes, name) {
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), model.Field(i).Addr().Interface())...)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v.validate(&data, &resp.Diagnostics)
+}
+
+// validateEnvironmentDefault validates the first of the environment variables that is set, which the attribute
+// defaults to, against the validator of the attribute.
+func validateEnvironmentDefault(attribute path.Path, v patternValidator, diags *diag.Diagnostics, names ...string) {
+	for _, name := range names {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		if !v.pattern.MatchString(value) {
+			diags.AddAttributeError(attribute, fmt.Sprintf("Invalid %s", attribute), fmt.Sprintf("The %s set by the %s environment variable must be %s, such as `%s`, got `%s`.", attribute, name, v.description, v.example, value))
+		}
+
+		return
+	}
+}
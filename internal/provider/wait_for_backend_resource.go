@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// waitForBackendPollInterval is how long to wait between two checks of the backend service.
+const waitForBackendPollInterval = 10 * time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WaitForBackendResource{}
+
+func NewWaitForBackendResource() resource.Resource {
+	return &WaitForBackendResource{}
+}
+
+// WaitForBackendResource defines the resource implementation.
+type WaitForBackendResource struct {
+	providerData *GKEGatewayProviderData
+}
+
+// WaitForBackendResourceModel describes the resource data model.
+type WaitForBackendResourceModel struct {
+	Gateway          types.String `tfsdk:"gateway"`
+	HealthyEndpoints types.Int64  `tfsdk:"healthy_endpoints"`
+	ID               types.String `tfsdk:"id"`
+	Namespace        types.String `tfsdk:"namespace"`
+	Project          types.String `tfsdk:"project"`
+	Region           types.String `tfsdk:"region"`
+	SecurityPolicy   types.String `tfsdk:"security_policy"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+func (r *WaitForBackendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*GKEGatewayProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *GKEGatewayProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *WaitForBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data WaitForBackendResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.wait(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Namespace.ValueString(), data.Gateway.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WaitForBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// There is nothing to wait for on destroy.
+}
+
+func (r *WaitForBackendResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wait_for_backend"
+}
+
+func (r *WaitForBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data WaitForBackendResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no remote object, the wait only happens when the conditions are applied.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WaitForBackendResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes gateway resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"healthy_endpoints": schema.Int64Attribute{
+				MarkdownDescription: "The number of endpoints, across all the backends of the backend service, that must be reported healthy by the load balancer.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier for the wait with format `{{namespace}}/{{gateway}}`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes namespace the gateway resource is in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required:   true,
+				Validators: []validator.String{rfc1123LabelValidator()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the load balancer belongs. If it is not provided, the provider project is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{projectIDValidator()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region in which the load balancer belongs. If it is not provided, the provider region is used. When neither are provided, the load balancer is presumed to be global.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{regionValidator()},
+			},
+			"security_policy": schema.StringAttribute{
+				MarkdownDescription: "Name of the Cloud Armor security policy that must be attached to the backend service.",
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString("10m"),
+				MarkdownDescription: "How long to wait for the conditions to be met before failing, as a duration such as `15m`. Defaults to `10m`.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Waits for the backend service of the load balancer created from a Kubernetes Gateway resource by GKE to exist and, optionally, to have enough healthy endpoints or a given security policy attached. Creating the resource, or changing its conditions, blocks until they are all met, so resources depending on it only run once GKE has programmed the load balancer. The same single backend service limitations as the `gkegateway_backend_service` data source apply.",
+	}
+}
+
+func (r *WaitForBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.providerData.withUserAgent(ctx, req.ProviderMeta)
+
+	var data WaitForBackendResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.wait(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// wait polls the backend service of the gateway until every configured condition is met or the timeout expires.
+func (r *WaitForBackendResource) wait(ctx context.Context, data *WaitForBackendResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout, err := time.ParseDuration(data.Timeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timeout"), "Invalid timeout", fmt.Sprintf("The timeout must be a duration such as `10m`: %s", err))
+		return diags
+	}
+
+	project, region, scopeDiags := r.providerData.resolveScope(data.Project, data.Region)
+	diags.Append(scopeDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		pending, checkDiags := r.check(ctx, project, region, data)
+		diags.Append(checkDiags...)
+
+		if diags.HasError() || pending == "" {
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Timed out waiting for backend service", fmt.Sprintf("Gateway %s/%s did not meet its conditions within %s: %s.", data.Namespace.ValueString(), data.Gateway.ValueString(), timeout, pending))
+			return diags
+		case <-time.After(waitForBackendPollInterval):
+		}
+	}
+}
+
+// check returns the first condition that isn't met yet, or an empty string once they all are.
+func (r *WaitForBackendResource) check(ctx context.Context, project string, region types.String, data *WaitForBackendResourceModel) (string, diag.Diagnostics) {
+	backendService, diags := r.providerData.lookupBackendService(ctx, project, region, data.Namespace.ValueString(), data.Gateway.ValueString())
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if backendService == nil {
+		return "the load balancer does not exist yet", diags
+	}
+
+	if !data.SecurityPolicy.IsNull() {
+		securityPolicyComponents := strings.Split(backendService.GetSecurityPolicy(), "/")
+
+		if securityPolicyComponents[len(securityPolicyComponents)-1] != data.SecurityPolicy.ValueString() {
+			return fmt.Sprintf("the %s security policy is not attached", data.SecurityPolicy.ValueString()), diags
+		}
+	}
+
+	if !data.HealthyEndpoints.IsNull() {
+		healthyEndpoints, healthDiags := r.providerData.countHealthyEndpoints(ctx, project, region, backendService)
+		diags.Append(healthDiags...)
+
+		if diags.HasError() {
+			return "", diags
+		}
+
+		if healthyEndpoints < data.HealthyEndpoints.ValueInt64() {
+			return fmt.Sprintf("%d of %d endpoints are healthy", healthyEndpoints, data.HealthyEndpoints.ValueInt64()), diags
+		}
+	}
+
+	return "", diags
+}
+
+// countHealthyEndpoints returns the number of endpoints the load balancer reports healthy across all the backends of
+// the backend service.
+func (p *GKEGatewayProviderData) countHealthyEndpoints(ctx context.Context, project string, region types.String, backendService *computepb.BackendService) (int64, diag.Diagnostics) {
+	var (
+		diags            diag.Diagnostics
+		healthyEndpoints int64
+	)
+
+	for _, backend := range backendService.GetBackends() {
+		var (
+			err    error
+			health *computepb.BackendServiceGroupHealth
+		)
+
+		if region.IsNull() {
+			health, err = p.backendServicesClient.GetHealth(ctx, &computepb.GetHealthBackendServiceRequest{
+				BackendService: backendService.GetName(),
+				Project:        selfLinkProject(backendService.GetSelfLink(), project),
+				ResourceGroupReferenceResource: &computepb.ResourceGroupReference{
+					Group: backend.Group,
+				},
+			})
+		} else {
+			health, err = p.regionBackendServicesClient.GetHealth(ctx, &computepb.GetHealthRegionBackendServiceRequest{
+				BackendService: backendService.GetName(),
+				Project:        selfLinkProject(backendService.GetSelfLink(), project),
+				Region:         region.ValueString(),
+				ResourceGroupReferenceResource: &computepb.ResourceGroupReference{
+					Group: backend.Group,
+				},
+			})
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error looking up the health of backend service %s", backendService.GetName()), fmt.Sprintf("Error calling Google API: %+v", err))
+			return 0, diags
+		}
+
+		for _, status := range health.GetHealthStatus() {
+			if status.GetHealthState() == "HEALTHY" {
+				healthyEndpoints++
+			}
+		}
+	}
+
+	return healthyEndpoints, diags
+}